@@ -0,0 +1,188 @@
+package mocka
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// argumentCall describes a call of the function argument at the index
+// with the provided arguments made when the stub is called
+type argumentCall struct {
+	index int
+	args  []interface{}
+	async bool
+}
+
+// callbackResults holds the return values of the function arguments called
+// by a stub. It is shared by all copies of a Call so that the results of
+// asynchronous calls are available once they complete.
+type callbackResults struct {
+	lock    sync.Mutex
+	results [][]interface{}
+	done    chan struct{}
+}
+
+// newCallbackResults creates the holder of the return values of the function
+// argument calls with a done channel that is closed by callAllAsync
+func newCallbackResults() *callbackResults {
+	return &callbackResults{done: make(chan struct{})}
+}
+
+// append appends the return values of a function argument call
+func (cr *callbackResults) append(results []interface{}) {
+	cr.lock.Lock()
+	defer cr.lock.Unlock()
+
+	cr.results = append(cr.results, results)
+}
+
+// get returns a copy of the return values of the function argument calls
+func (cr *callbackResults) get() [][]interface{} {
+	cr.lock.Lock()
+	defer cr.lock.Unlock()
+
+	return append([][]interface{}(nil), cr.results...)
+}
+
+// callAll calls the function arguments in sequence and
+// appends the return values of every successful call
+func (cr *callbackResults) callAll(testReporter TestReporter, arguments []interface{}, calls []argumentCall) {
+	for _, ac := range calls {
+		if results, ok := callArgument(testReporter, arguments, ac); ok {
+			cr.append(results)
+		}
+	}
+}
+
+// callAllAsync calls the function arguments in sequence and closes the done
+// channel once they complete. A function argument that panics fails the test
+// instead of crashing the test binary, and the remaining calls are still made.
+func (cr *callbackResults) callAllAsync(testReporter TestReporter, arguments []interface{}, calls []argumentCall) {
+	defer close(cr.done)
+
+	for _, ac := range calls {
+		cr.callRecovering(testReporter, arguments, ac)
+	}
+}
+
+// callRecovering calls the function argument and appends its return values,
+// reporting a panic of the function argument to fail the test
+func (cr *callbackResults) callRecovering(testReporter TestReporter, arguments []interface{}, ac argumentCall) {
+	defer func() {
+		if r := recover(); r != nil {
+			testReporter.Errorf("mocka: asynchronous call of argument %v panicked: %v", ac.index, r)
+		}
+	}()
+
+	if results, ok := callArgument(testReporter, arguments, ac); ok {
+		cr.append(results)
+	}
+}
+
+// wait blocks until the asynchronous calls complete or the timeout elapses.
+// It returns true if the calls completed; otherwise false.
+func (cr *callbackResults) wait(timeout time.Duration) bool {
+	if cr.done == nil {
+		return true
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-cr.done:
+		return true
+	case <-timer.C:
+		return false
+	}
+}
+
+// partitionArgumentCalls splits the function argument calls into the
+// calls made before the stub returns and the asynchronous calls
+func partitionArgumentCalls(calls []argumentCall) (synchronous, asynchronous []argumentCall) {
+	for _, ac := range calls {
+		if ac.async {
+			asynchronous = append(asynchronous, ac)
+		} else {
+			synchronous = append(synchronous, ac)
+		}
+	}
+
+	return
+}
+
+// areArgumentsAssignable returns true if the function can be called with the arguments
+func areArgumentsAssignable(functionType reflect.Type, args []interface{}) bool {
+	if len(args) < functionType.NumIn()-1 || (!functionType.IsVariadic() && len(args) != functionType.NumIn()) {
+		return false
+	}
+
+	for i, arg := range args {
+		if t, ok := argumentType(functionType, i); !ok || !isAssignableValue(t, arg) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validateArgumentCall reports an argument index that is out of range, an
+// argument that is not a function or arguments the function cannot be called
+// with to fail the test. Arguments of an interface type are validated when
+// the stub is called.
+func validateArgumentCall(testReporter TestReporter, functionType reflect.Type, ac argumentCall) bool {
+	t, ok := argumentType(functionType, ac.index)
+	switch {
+	case !ok:
+		testReporter.Errorf("mocka: argument index %v is out of range for arguments of type (%v)", ac.index, strings.Join(argumentTypeNames(functionType), ", "))
+		return false
+	case t.Kind() == reflect.Interface:
+		return true
+	case t.Kind() != reflect.Func:
+		testReporter.Errorf("mocka: expected argument %v to be a function to call it, but it is of type %v", ac.index, toFriendlyName(t))
+		return false
+	case !areArgumentsAssignable(t, ac.args):
+		reportInvalidArgumentCall(testReporter, t, ac)
+		return false
+	}
+
+	return true
+}
+
+// reportInvalidArgumentCall reports arguments a function argument
+// cannot be called with to fail the test
+func reportInvalidArgumentCall(testReporter TestReporter, functionType reflect.Type, ac argumentCall) {
+	expected, received := distinctTypeNames(argumentTypes(functionType), ac.args)
+	testReporter.Errorf("mocka: expected arguments of type (%v) to call argument %v, but received (%v)", strings.Join(markVariadic(functionType, expected), ", "), ac.index, strings.Join(received, ", "))
+}
+
+// callArgument calls the function argument at the index with the arguments
+// and returns its return values. An argument that is missing, is not a
+// non-nil function or cannot be called with the arguments fails the test.
+func callArgument(testReporter TestReporter, arguments []interface{}, ac argumentCall) ([]interface{}, bool) {
+	if ac.index >= len(arguments) {
+		testReporter.Errorf("mocka: could not call argument %v, the function was called with %v argument(s)", ac.index, len(arguments))
+		return nil, false
+	}
+
+	fn := reflect.ValueOf(arguments[ac.index])
+	if fn.Kind() != reflect.Func || fn.IsNil() {
+		testReporter.Errorf("mocka: could not call argument %v, expected a non-nil function but received %v", ac.index, toFriendlyName(arguments[ac.index]))
+		return nil, false
+	}
+
+	if !areArgumentsAssignable(fn.Type(), ac.args) {
+		reportInvalidArgumentCall(testReporter, fn.Type(), ac)
+		return nil, false
+	}
+
+	in := make([]reflect.Value, len(ac.args))
+	for i, arg := range ac.args {
+		t, _ := argumentType(fn.Type(), i)
+		in[i], _ = toAssignableValue(t, arg)
+	}
+
+	return mapToInterfaces(fn.Call(in)), true
+}
@@ -0,0 +1,213 @@
+package mocka
+
+import (
+	"errors"
+	"reflect"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("argumentCall", func() {
+	var (
+		reporter     *mockTestReporter
+		functionType reflect.Type
+	)
+
+	BeforeEach(func() {
+		reporter = &mockTestReporter{}
+
+		fn := func(root string, walkFn func(string, error) error, handler interface{}) error {
+			return nil
+		}
+		functionType = reflect.TypeOf(fn)
+	})
+
+	Describe("callbackResults", func() {
+		It("returns a copy of the appended return values", func() {
+			cr := &callbackResults{}
+
+			cr.append([]interface{}{1})
+			cr.append([]interface{}{2})
+			results := cr.get()
+			results[0] = nil
+
+			Expect(cr.get()).To(Equal([][]interface{}{{1}, {2}}))
+		})
+	})
+
+	Describe("callAll", func() {
+		It("calls the function arguments in sequence and appends the return values of every successful call", func() {
+			var order []string
+			walkFn := func(path string, err error) error {
+				order = append(order, path)
+				return err
+			}
+			cr := &callbackResults{}
+
+			cr.callAll(reporter, []interface{}{"/", walkFn}, []argumentCall{
+				{index: 1, args: []interface{}{"/a", nil}},
+				{index: 0, args: []interface{}{}},
+				{index: 1, args: []interface{}{"/b", errors.New("Ope")}},
+			})
+
+			Expect(order).To(Equal([]string{"/a", "/b"}))
+			Expect(cr.get()).To(Equal([][]interface{}{{nil}, {errors.New("Ope")}}))
+			Expect(reporter.messages).To(HaveLen(1))
+		})
+	})
+
+	Describe("callAllAsync", func() {
+		It("reports a panicking function argument, makes the remaining calls and completes", func() {
+			cr := newCallbackResults()
+			panicFn := func() { panic("Ope") }
+			answerFn := func() int { return 42 }
+
+			cr.callAllAsync(reporter, []interface{}{panicFn, answerFn}, []argumentCall{
+				{index: 0, args: []interface{}{}, async: true},
+				{index: 1, args: []interface{}{}, async: true},
+			})
+
+			Expect(cr.done).To(BeClosed())
+			Expect(cr.get()).To(Equal([][]interface{}{{42}}))
+			Expect(reporter.messages).To(Equal([]string{"mocka: asynchronous call of argument 0 panicked: Ope"}))
+		})
+	})
+
+	Describe("wait", func() {
+		It("returns true once the asynchronous calls complete", func() {
+			cr := newCallbackResults()
+			close(cr.done)
+
+			Expect(cr.wait(time.Second)).To(BeTrue())
+			Expect((&callbackResults{}).wait(time.Second)).To(BeTrue())
+		})
+
+		It("returns false if the timeout elapses", func() {
+			Expect(newCallbackResults().wait(time.Millisecond)).To(BeFalse())
+		})
+	})
+
+	Describe("partitionArgumentCalls", func() {
+		It("splits the synchronous and asynchronous calls preserving their order", func() {
+			calls := []argumentCall{{index: 0}, {index: 1, async: true}, {index: 2}, {index: 3, async: true}}
+
+			synchronous, asynchronous := partitionArgumentCalls(calls)
+
+			Expect(synchronous).To(Equal([]argumentCall{{index: 0}, {index: 2}}))
+			Expect(asynchronous).To(Equal([]argumentCall{{index: 1, async: true}, {index: 3, async: true}}))
+		})
+	})
+
+	Describe("areArgumentsAssignable", func() {
+		It("returns true if the function can be called with the arguments", func() {
+			Expect(areArgumentsAssignable(reflect.TypeOf(func(string, error) {}), []interface{}{"/", nil})).To(BeTrue())
+			Expect(areArgumentsAssignable(reflect.TypeOf(func(string, ...int) {}), []interface{}{"/"})).To(BeTrue())
+			Expect(areArgumentsAssignable(reflect.TypeOf(func(string, ...int) {}), []interface{}{"/", 1, 2})).To(BeTrue())
+		})
+
+		It("returns false if the number of arguments does not match", func() {
+			Expect(areArgumentsAssignable(reflect.TypeOf(func(string, error) {}), []interface{}{"/"})).To(BeFalse())
+			Expect(areArgumentsAssignable(reflect.TypeOf(func(string, ...int) {}), []interface{}{})).To(BeFalse())
+		})
+
+		It("returns false if an argument is not assignable", func() {
+			Expect(areArgumentsAssignable(reflect.TypeOf(func(string, error) {}), []interface{}{"/", "Ope"})).To(BeFalse())
+			Expect(areArgumentsAssignable(reflect.TypeOf(func(string, ...int) {}), []interface{}{"/", 1, "2"})).To(BeFalse())
+		})
+	})
+
+	Describe("validateArgumentCall", func() {
+		It("returns true for arguments the function argument can be called with", func() {
+			Expect(validateArgumentCall(reporter, functionType, argumentCall{index: 1, args: []interface{}{"/", nil}})).To(BeTrue())
+			Expect(reporter.messages).To(BeEmpty())
+		})
+
+		It("returns true for any arguments of an interface argument", func() {
+			Expect(validateArgumentCall(reporter, functionType, argumentCall{index: 2, args: []interface{}{42}})).To(BeTrue())
+			Expect(reporter.messages).To(BeEmpty())
+		})
+
+		It("reports an index out of range", func() {
+			Expect(validateArgumentCall(reporter, functionType, argumentCall{index: 3})).To(BeFalse())
+			Expect(reporter.messages).To(HaveLen(1))
+			Expect(reporter.messages[0]).To(HavePrefix("mocka: argument index 3 is out of range for arguments of type (string, "))
+		})
+
+		It("reports an argument that is not a function", func() {
+			Expect(validateArgumentCall(reporter, functionType, argumentCall{index: 0})).To(BeFalse())
+			Expect(reporter.messages).To(ConsistOf("mocka: expected argument 0 to be a function to call it, but it is of type string"))
+		})
+
+		It("reports arguments the function argument cannot be called with", func() {
+			Expect(validateArgumentCall(reporter, functionType, argumentCall{index: 1, args: []interface{}{42}})).To(BeFalse())
+			Expect(reporter.messages).To(ConsistOf("mocka: expected arguments of type (string, error) to call argument 1, but received (int)"))
+		})
+	})
+
+	Describe("callArgument", func() {
+		It("calls the function argument with the arguments and returns its return values", func() {
+			var received []int
+			fn := func(values ...int) (int, error) {
+				received = values
+				return len(values), nil
+			}
+
+			results, ok := callArgument(reporter, []interface{}{"/", fn}, argumentCall{index: 1, args: []interface{}{1, 2}})
+
+			Expect(ok).To(BeTrue())
+			Expect(received).To(Equal([]int{1, 2}))
+			Expect(results).To(Equal([]interface{}{2, nil}))
+		})
+
+		It("calls the function argument with zero values for nil arguments", func() {
+			var received error = errors.New("not called")
+			fn := func(err error) {
+				received = err
+			}
+
+			_, ok := callArgument(reporter, []interface{}{fn}, argumentCall{index: 0, args: []interface{}{nil}})
+
+			Expect(ok).To(BeTrue())
+			Expect(received).To(BeNil())
+		})
+
+		It("calls the function argument with untyped constants converted to the argument types", func() {
+			var received int64
+			fn := func(n int64) {
+				received = n
+			}
+
+			_, ok := callArgument(reporter, []interface{}{fn}, argumentCall{index: 0, args: []interface{}{7}})
+
+			Expect(ok).To(BeTrue())
+			Expect(received).To(Equal(int64(7)))
+		})
+
+		It("reports a missing argument", func() {
+			_, ok := callArgument(reporter, []interface{}{"/"}, argumentCall{index: 1})
+
+			Expect(ok).To(BeFalse())
+			Expect(reporter.messages).To(ConsistOf("mocka: could not call argument 1, the function was called with 1 argument(s)"))
+		})
+
+		It("reports an argument that is not a non-nil function", func() {
+			var nilFn func()
+
+			_, ok := callArgument(reporter, []interface{}{nilFn}, argumentCall{index: 0})
+
+			Expect(ok).To(BeFalse())
+			Expect(reporter.messages).To(ConsistOf("mocka: could not call argument 0, expected a non-nil function but received func() {}"))
+		})
+
+		It("reports arguments the function argument cannot be called with", func() {
+			fn := func(string) {}
+
+			_, ok := callArgument(reporter, []interface{}{fn}, argumentCall{index: 0, args: []interface{}{42}})
+
+			Expect(ok).To(BeFalse())
+			Expect(reporter.messages).To(ConsistOf("mocka: expected arguments of type (string) to call argument 0, but received (int)"))
+		})
+	})
+})
@@ -0,0 +1,120 @@
+package mocka
+
+import (
+	"reflect"
+	"strings"
+)
+
+// argumentValue describes a value written through the pointer argument
+// at the index before a call returns
+type argumentValue struct {
+	index int
+	value interface{}
+}
+
+// argumentType returns the type of the argument at the index. Indexes at or
+// after the variadic argument use the element type of the variadic argument.
+func argumentType(functionType reflect.Type, index int) (reflect.Type, bool) {
+	last := functionType.NumIn() - 1
+	switch {
+	case index < 0:
+		return nil, false
+	case functionType.IsVariadic() && index >= last:
+		return functionType.In(last).Elem(), true
+	case index > last:
+		return nil, false
+	}
+
+	return functionType.In(index), true
+}
+
+// isAssignableValue returns true if the value can be assigned to a variable
+// of the type, following the same conversion rules as return values
+func isAssignableValue(t reflect.Type, value interface{}) bool {
+	_, ok := convertValue(t, value)
+	return ok
+}
+
+// toAssignableValue returns the value converted to the type as a reflect.Value
+// that can be assigned to a variable of the type. A nil value is returned as
+// the zero value of the type.
+func toAssignableValue(t reflect.Type, value interface{}) (reflect.Value, bool) {
+	converted, ok := convertValue(t, value)
+	if !ok {
+		return reflect.Value{}, false
+	}
+
+	if converted == nil {
+		return reflect.Zero(t), true
+	}
+
+	return reflect.ValueOf(converted), true
+}
+
+// validateArgumentValue reports an argument index that is out of range, an
+// argument that cannot be written through or a value that cannot be assigned
+// to the pointed-to value to fail the test. Arguments of an interface type
+// are validated when the stub is called.
+func validateArgumentValue(testReporter TestReporter, functionType reflect.Type, av argumentValue) bool {
+	t, ok := argumentType(functionType, av.index)
+	switch {
+	case !ok:
+		testReporter.Errorf("mocka: argument index %v is out of range for arguments of type (%v)", av.index, strings.Join(argumentTypeNames(functionType), ", "))
+		return false
+	case t.Kind() == reflect.Interface:
+		return true
+	case t.Kind() != reflect.Ptr:
+		testReporter.Errorf("mocka: expected argument %v to be a pointer to set its value, but it is of type %v", av.index, toFriendlyName(t))
+		return false
+	case !isAssignableValue(t.Elem(), av.value):
+		expected, received := distinctTypeNames([]reflect.Type{t.Elem()}, []interface{}{av.value})
+		testReporter.Errorf("mocka: expected a value of type %v to set argument %v, but received %v", expected[0], av.index, received[0])
+		return false
+	}
+
+	return true
+}
+
+// spreadArguments returns the arguments with the elements of the variadic
+// argument in place of its slice, so that indexes at or after the variadic
+// argument address its elements
+func spreadArguments(functionType reflect.Type, arguments []interface{}) []interface{} {
+	if !functionType.IsVariadic() || len(arguments) == 0 {
+		return arguments
+	}
+
+	last := len(arguments) - 1
+	spread := append([]interface{}{}, arguments[:last]...)
+	variadic := reflect.ValueOf(arguments[last])
+	for i := 0; variadic.IsValid() && i < variadic.Len(); i++ {
+		spread = append(spread, variadic.Index(i).Interface())
+	}
+
+	return spread
+}
+
+// writeArgumentValue assigns the value to the value pointed to by the argument
+// at the index. An argument that is missing, is not a non-nil pointer or whose
+// pointed-to value cannot be assigned the value fails the test.
+func writeArgumentValue(testReporter TestReporter, arguments []interface{}, av argumentValue) {
+	if av.index >= len(arguments) {
+		testReporter.Errorf("mocka: could not set argument %v, the function was called with %v argument(s)", av.index, len(arguments))
+		return
+	}
+
+	pointer := reflect.ValueOf(arguments[av.index])
+	if pointer.Kind() != reflect.Ptr || pointer.IsNil() {
+		testReporter.Errorf("mocka: could not set argument %v, expected a non-nil pointer but received %v", av.index, toFriendlyName(arguments[av.index]))
+		return
+	}
+
+	elem := pointer.Elem()
+	value, ok := toAssignableValue(elem.Type(), av.value)
+	if !ok {
+		expected, received := distinctTypeNames([]reflect.Type{elem.Type()}, []interface{}{av.value})
+		testReporter.Errorf("mocka: could not set argument %v, expected a value of type %v but received %v", av.index, expected[0], received[0])
+		return
+	}
+
+	elem.Set(value)
+}
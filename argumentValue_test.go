@@ -0,0 +1,200 @@
+package mocka
+
+import (
+	"errors"
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("argumentValue", func() {
+	var (
+		reporter     *mockTestReporter
+		functionType reflect.Type
+		variadicType reflect.Type
+	)
+
+	BeforeEach(func() {
+		reporter = &mockTestReporter{}
+
+		fn := func(data []byte, v interface{}, n *int, str string) error {
+			return nil
+		}
+		functionType = reflect.TypeOf(fn)
+
+		variadicFn := func(query string, dest ...interface{}) error {
+			return nil
+		}
+		variadicType = reflect.TypeOf(variadicFn)
+	})
+
+	Describe("argumentType", func() {
+		It("returns the type of the argument at the index", func() {
+			t, ok := argumentType(functionType, 2)
+
+			Expect(ok).To(BeTrue())
+			Expect(t).To(Equal(reflect.TypeOf(new(int))))
+		})
+
+		It("returns false for an index out of range", func() {
+			_, ok := argumentType(functionType, -1)
+			Expect(ok).To(BeFalse())
+
+			_, ok = argumentType(functionType, 4)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("returns the element type of the variadic argument for indexes at or after it", func() {
+			for _, index := range []int{1, 2, 10} {
+				t, ok := argumentType(variadicType, index)
+
+				Expect(ok).To(BeTrue())
+				Expect(t.Kind()).To(Equal(reflect.Interface))
+			}
+		})
+	})
+
+	Describe("isAssignableValue", func() {
+		It("returns true if the value is assignable to the type", func() {
+			Expect(isAssignableValue(reflect.TypeOf(0), 42)).To(BeTrue())
+			Expect(isAssignableValue(reflect.TypeOf((*error)(nil)).Elem(), errors.New("Ope"))).To(BeTrue())
+		})
+
+		It("returns true if an untyped constant converts to the type without losing information", func() {
+			Expect(isAssignableValue(reflect.TypeOf(int64(0)), 42)).To(BeTrue())
+			Expect(isAssignableValue(reflect.TypeOf(uint8(0)), 256)).To(BeFalse())
+		})
+
+		It("returns false if the value is not assignable to the type", func() {
+			Expect(isAssignableValue(reflect.TypeOf(0), "42")).To(BeFalse())
+			Expect(isAssignableValue(reflect.TypeOf(0), int64(42))).To(BeFalse())
+		})
+
+		It("returns true for a nil value only if the type can be nil", func() {
+			Expect(isAssignableValue(reflect.TypeOf([]int{}), nil)).To(BeTrue())
+			Expect(isAssignableValue(reflect.TypeOf(new(int)), nil)).To(BeTrue())
+			Expect(isAssignableValue(reflect.TypeOf(0), nil)).To(BeFalse())
+		})
+	})
+
+	Describe("toAssignableValue", func() {
+		It("returns the value converted to the type", func() {
+			value, ok := toAssignableValue(reflect.TypeOf(int64(0)), 42)
+
+			Expect(ok).To(BeTrue())
+			Expect(value.Interface()).To(Equal(int64(42)))
+		})
+
+		It("returns the zero value of the type for a nil value", func() {
+			value, ok := toAssignableValue(reflect.TypeOf([]int{}), nil)
+
+			Expect(ok).To(BeTrue())
+			Expect(value.Interface()).To(BeNil())
+		})
+
+		It("returns false if the value cannot be assigned to the type", func() {
+			_, ok := toAssignableValue(reflect.TypeOf(0), "42")
+
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("validateArgumentValue", func() {
+		It("returns true for a value assignable to the pointed-to type", func() {
+			Expect(validateArgumentValue(reporter, functionType, argumentValue{index: 2, value: 42})).To(BeTrue())
+			Expect(reporter.messages).To(BeEmpty())
+		})
+
+		It("returns true for any value of an interface argument", func() {
+			Expect(validateArgumentValue(reporter, functionType, argumentValue{index: 1, value: "anything"})).To(BeTrue())
+			Expect(validateArgumentValue(reporter, variadicType, argumentValue{index: 3, value: 42})).To(BeTrue())
+			Expect(reporter.messages).To(BeEmpty())
+		})
+
+		It("reports an index out of range", func() {
+			Expect(validateArgumentValue(reporter, variadicType, argumentValue{index: -1, value: 42})).To(BeFalse())
+			Expect(reporter.messages).To(HaveLen(1))
+			Expect(reporter.messages[0]).To(HavePrefix("mocka: argument index -1 is out of range for arguments of type (string, ..."))
+		})
+
+		It("reports an argument that is not a pointer", func() {
+			Expect(validateArgumentValue(reporter, functionType, argumentValue{index: 3, value: "hello"})).To(BeFalse())
+			Expect(reporter.messages).To(ConsistOf("mocka: expected argument 3 to be a pointer to set its value, but it is of type string"))
+		})
+
+		It("reports a value that cannot be assigned to the pointed-to type", func() {
+			Expect(validateArgumentValue(reporter, functionType, argumentValue{index: 2, value: "42"})).To(BeFalse())
+			Expect(reporter.messages).To(ConsistOf("mocka: expected a value of type int to set argument 2, but received string"))
+		})
+	})
+
+	Describe("spreadArguments", func() {
+		It("returns the arguments of a function that is not variadic", func() {
+			arguments := []interface{}{[]byte("{}"), 42}
+
+			Expect(spreadArguments(functionType, arguments)).To(Equal([]interface{}{[]byte("{}"), 42}))
+		})
+
+		It("spreads the elements of the variadic argument", func() {
+			arguments := []interface{}{"SELECT 1", []interface{}{1, "two"}}
+
+			Expect(spreadArguments(variadicType, arguments)).To(Equal([]interface{}{"SELECT 1", 1, "two"}))
+		})
+	})
+
+	Describe("writeArgumentValue", func() {
+		It("assigns the value to the value pointed to by the argument", func() {
+			var n int
+
+			writeArgumentValue(reporter, []interface{}{"hello", &n}, argumentValue{index: 1, value: 42})
+
+			Expect(n).To(Equal(42))
+			Expect(reporter.messages).To(BeEmpty())
+		})
+
+		It("assigns the zero value for a nil value", func() {
+			s := []int{1, 2}
+
+			writeArgumentValue(reporter, []interface{}{&s}, argumentValue{index: 0, value: nil})
+
+			Expect(s).To(BeNil())
+		})
+
+		It("assigns an untyped constant converted to the pointed-to type", func() {
+			var n int64
+
+			writeArgumentValue(reporter, []interface{}{&n}, argumentValue{index: 0, value: 7})
+
+			Expect(n).To(Equal(int64(7)))
+			Expect(reporter.messages).To(BeEmpty())
+		})
+
+		It("reports a missing argument", func() {
+			writeArgumentValue(reporter, []interface{}{"hello"}, argumentValue{index: 2, value: 42})
+
+			Expect(reporter.messages).To(ConsistOf("mocka: could not set argument 2, the function was called with 1 argument(s)"))
+		})
+
+		It("reports an argument that is not a non-nil pointer", func() {
+			var nilPointer *int
+
+			writeArgumentValue(reporter, []interface{}{42, nilPointer}, argumentValue{index: 0, value: 42})
+			writeArgumentValue(reporter, []interface{}{42, nilPointer}, argumentValue{index: 1, value: 42})
+
+			Expect(reporter.messages).To(Equal([]string{
+				"mocka: could not set argument 0, expected a non-nil pointer but received int",
+				"mocka: could not set argument 1, expected a non-nil pointer but received *int",
+			}))
+		})
+
+		It("reports a value that cannot be assigned to the pointed-to value", func() {
+			var n int
+
+			writeArgumentValue(reporter, []interface{}{&n}, argumentValue{index: 0, value: "42"})
+
+			Expect(n).To(BeZero())
+			Expect(reporter.messages).To(ConsistOf("mocka: could not set argument 0, expected a value of type int but received string"))
+		})
+	})
+})
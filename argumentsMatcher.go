@@ -0,0 +1,203 @@
+package mocka
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/MonsantoCo/mocka/v2/match"
+)
+
+// argumentsMatcher matches the arguments of captured calls against a set of
+// expected arguments. The matchers are built the same way as for WithArgs,
+// so the expected arguments mean the same as they do for WithArgs.
+type argumentsMatcher struct {
+	testReporter TestReporter
+	functionType reflect.Type
+	arguments    []interface{}
+	matchers     []match.SupportedKindsMatcher
+}
+
+// newArgumentsMatcher returns a matcher for the expected arguments. Arguments
+// that are not valid for the function fail the test.
+func newArgumentsMatcher(testReporter TestReporter, functionType reflect.Type, arguments []interface{}) (argumentsMatcher, bool) {
+	if isArgumentLengthValid(functionType, arguments) {
+		reportInvalidArguments(testReporter, functionType, arguments)
+		return argumentsMatcher{}, false
+	}
+
+	if !validateMatchers(testReporter, arguments) {
+		return argumentsMatcher{}, false
+	}
+
+	matchers := getMatchers(functionType, arguments)
+	if matchers == nil {
+		reportInvalidArguments(testReporter, functionType, arguments)
+		return argumentsMatcher{}, false
+	}
+
+	return argumentsMatcher{testReporter: testReporter, functionType: functionType, arguments: arguments, matchers: matchers}, true
+}
+
+// matches returns true if the arguments match the expected arguments
+func (am argumentsMatcher) matches(arguments []interface{}) bool {
+	return matchArguments(am.testReporter, am.matchers, arguments)
+}
+
+// exactArguments returns the arguments of the call as they are, so they
+// match the expected arguments the same way as for WithArgs
+func (am argumentsMatcher) exactArguments(call Call) []interface{} {
+	return call.args
+}
+
+// prefixArguments returns the arguments of the call with its variadic
+// argument cut down to the number of expected variadic arguments. It is
+// the one exception to the expected arguments meaning the same as for
+// WithArgs: trailing variadic arguments of the call are left out.
+func (am argumentsMatcher) prefixArguments(call Call) []interface{} {
+	last := len(call.args) - 1
+	if !am.functionType.IsVariadic() || last < 0 {
+		return call.args
+	}
+
+	expected := len(am.arguments) - (am.functionType.NumIn() - 1)
+	variadic := reflect.ValueOf(call.args[last])
+	if !variadic.IsValid() || variadic.Len() <= expected {
+		return call.args
+	}
+
+	prefix := variadic.Slice(0, expected)
+	if expected == 0 {
+		prefix = reflect.Zero(variadic.Type())
+	}
+
+	return append(append([]interface{}{}, call.args[:last]...), prefix.Interface())
+}
+
+// explainMismatch returns why the arguments did not match the
+// expected arguments
+func (am argumentsMatcher) explainMismatch(arguments []interface{}) string {
+	return explainArguments(am.matchers, arguments)
+}
+
+// explainArguments returns why the first argument that does not match its
+// matcher did not match. It returns an empty string if every argument matches.
+// Panics from inside the predicates of matchers are not reported again.
+func explainArguments(matchers []match.SupportedKindsMatcher, arguments []interface{}) string {
+	for i, m := range matchers {
+		if i >= len(arguments) {
+			return fmt.Sprintf("argument %v: expected %v but got nothing", i, match.DescriptionOf(m))
+		}
+
+		if !matchArguments(nil, matchers[i:i+1], arguments[i:i+1]) {
+			return fmt.Sprintf("argument %v: %v", i, explainMismatch(m, arguments[i]))
+		}
+	}
+
+	return ""
+}
+
+// explainMismatch returns why the argument did not match the matcher, or the
+// panic from inside the predicate of the matcher while explaining it
+func explainMismatch(matcher match.SupportedKindsMatcher, argument interface{}) (explanation string) {
+	defer func() {
+		if r := recover(); r != nil {
+			explanation = fmt.Sprint(r)
+		}
+	}()
+
+	return match.ExplainMismatch(matcher, argument)
+}
+
+// matchArguments returns false if any of the matchers does not match its
+// argument or if there is a panic from inside a matcher; otherwise true.
+// A panic from inside the predicate of a matcher is reported to fail the test.
+func matchArguments(testReporter TestReporter, matchers []match.SupportedKindsMatcher, arguments []interface{}) (isMatch bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			reportPredicatePanic(testReporter, r)
+			isMatch = false
+		}
+	}()
+
+	for i, m := range matchers {
+		if !m.Match(arguments[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// callMatches holds the captured calls of a stub, whether each of them
+// matched a set of expected arguments and why the calls that did not match
+// did not match
+type callMatches struct {
+	calls      []Call
+	matched    []bool
+	mismatches []string
+}
+
+// any returns true if at least one call matched
+func (cm callMatches) any() bool {
+	for _, matched := range cm.matched {
+		if matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// all returns true if there was at least one call and every call matched
+func (cm callMatches) all() bool {
+	for _, matched := range cm.matched {
+		if !matched {
+			return false
+		}
+	}
+
+	return len(cm.matched) > 0
+}
+
+// none returns true if no call matched
+func (cm callMatches) none() bool {
+	return !cm.any()
+}
+
+// argumentAssertion describes how the captured calls of a stub have to match
+// a set of expected arguments. The arguments of each call are taken as they
+// are, or with trailing variadic arguments left out. Mismatches are only
+// explained for assertions that expect calls to match.
+type argumentAssertion struct {
+	description    string
+	arguments      func(argumentsMatcher, Call) []interface{}
+	isMet          func(callMatches) bool
+	expectsMatches bool
+}
+
+var (
+	calledWith        = argumentAssertion{description: "to be called with (%v)", arguments: argumentsMatcher.prefixArguments, isMet: callMatches.any, expectsMatches: true}
+	calledWithExactly = argumentAssertion{description: "to be called with exactly (%v)", arguments: argumentsMatcher.exactArguments, isMet: callMatches.any, expectsMatches: true}
+	alwaysCalledWith  = argumentAssertion{description: "to always be called with (%v)", arguments: argumentsMatcher.prefixArguments, isMet: callMatches.all, expectsMatches: true}
+	neverCalledWith   = argumentAssertion{description: "to never be called with (%v)", arguments: argumentsMatcher.prefixArguments, isMet: callMatches.none}
+)
+
+// describe returns a human readable description of the assertion
+func (a argumentAssertion) describe(functionType reflect.Type, arguments []interface{}) string {
+	subject := strings.TrimSuffix(toFriendlyName(functionType), " {}")
+	return fmt.Sprintf("%v "+a.description, subject, strings.Join(mapToDescriptions(arguments), ", "))
+}
+
+// calledWithAt returns the assertion that the call at the index matched
+func calledWithAt(index int) argumentAssertion {
+	return argumentAssertion{
+		description: "to be called with (%v) at call index " + strconv.Itoa(index),
+		arguments:   argumentsMatcher.prefixArguments,
+		isMet: func(cm callMatches) bool {
+			return index >= 0 && index < len(cm.matched) && cm.matched[index]
+		},
+		expectsMatches: true,
+	}
+}
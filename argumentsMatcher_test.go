@@ -0,0 +1,223 @@
+package mocka
+
+import (
+	"reflect"
+
+	"github.com/MonsantoCo/mocka/v2/match"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("argumentsMatcher", func() {
+	var (
+		reporter     *mockTestReporter
+		functionType reflect.Type
+		variadicType reflect.Type
+	)
+
+	BeforeEach(func() {
+		reporter = &mockTestReporter{}
+
+		fn := func(str string, num int) (int, error) {
+			return len(str) + num, nil
+		}
+		functionType = reflect.TypeOf(fn)
+
+		variadicFn := func(query string, args ...int) error {
+			return nil
+		}
+		variadicType = reflect.TypeOf(variadicFn)
+	})
+
+	Describe("newArgumentsMatcher", func() {
+		It("returns the matchers WithArgs uses for the arguments", func() {
+			am, ok := newArgumentsMatcher(reporter, variadicType, []interface{}{"SELECT 1", 1, match.IntGreaterThan(1)})
+
+			Expect(ok).To(BeTrue())
+			Expect(am.matchers).To(Equal(getMatchers(variadicType, []interface{}{"SELECT 1", 1, match.IntGreaterThan(1)})))
+			Expect(reporter.messages).To(BeEmpty())
+		})
+
+		It("reports an error if the number of arguments is not valid", func() {
+			_, ok := newArgumentsMatcher(reporter, functionType, []interface{}{"hello"})
+
+			Expect(ok).To(BeFalse())
+			Expect(reporter.messages).To(Equal([]string{
+				"mocka: expected arguments of type (string, int), but received (string)",
+			}))
+		})
+
+		It("reports an error if an argument is not of the type of the function argument", func() {
+			_, ok := newArgumentsMatcher(reporter, variadicType, []interface{}{"SELECT 1", "one"})
+
+			Expect(ok).To(BeFalse())
+			Expect(reporter.messages).To(Equal([]string{
+				"mocka: expected arguments of type (string, ...int), but received (string, string)",
+			}))
+		})
+
+		It("reports an error if a matcher is not valid", func() {
+			_, ok := newArgumentsMatcher(reporter, functionType, []interface{}{match.StringMatching("["), 1})
+
+			Expect(ok).To(BeFalse())
+			Expect(reporter.messages).To(Equal([]string{
+				"mocka: invalid matcher for argument 0: error parsing regexp: missing closing ]: `[`",
+			}))
+		})
+	})
+
+	Describe("matches", func() {
+		It("returns true if the arguments match", func() {
+			am, _ := newArgumentsMatcher(reporter, functionType, []interface{}{"hello", match.IntLessThan(2)})
+
+			Expect(am.matches([]interface{}{"hello", 1})).To(BeTrue())
+			Expect(am.matches([]interface{}{"hello", 2})).To(BeFalse())
+		})
+
+		It("matches variadic arguments the same way as WithArgs", func() {
+			am, _ := newArgumentsMatcher(reporter, variadicType, []interface{}{"SELECT 1"})
+
+			Expect(am.matches([]interface{}{"SELECT 1", []int(nil)})).To(BeTrue())
+			Expect(am.matches([]interface{}{"SELECT 1", []int{1, 2}})).To(BeFalse())
+		})
+	})
+
+	Describe("exactArguments", func() {
+		It("returns the arguments of the call as they are", func() {
+			am, _ := newArgumentsMatcher(reporter, variadicType, []interface{}{"SELECT 1", 1})
+
+			Expect(am.exactArguments(Call{args: []interface{}{"SELECT 1", []int{1, 2}}})).
+				To(Equal([]interface{}{"SELECT 1", []int{1, 2}}))
+		})
+	})
+
+	Describe("prefixArguments", func() {
+		It("returns the arguments of a function that is not variadic as they are", func() {
+			am, _ := newArgumentsMatcher(reporter, functionType, []interface{}{"hello", 1})
+
+			Expect(am.prefixArguments(Call{args: []interface{}{"hello", 2}})).To(Equal([]interface{}{"hello", 2}))
+		})
+
+		It("leaves out trailing variadic arguments of the call", func() {
+			am, _ := newArgumentsMatcher(reporter, variadicType, []interface{}{"SELECT 1", 1})
+
+			arguments := am.prefixArguments(Call{args: []interface{}{"SELECT 1", []int{1, 2}}})
+
+			Expect(arguments).To(Equal([]interface{}{"SELECT 1", []int{1}}))
+			Expect(am.matches(arguments)).To(BeTrue())
+		})
+
+		It("leaves out every variadic argument of the call if none are expected", func() {
+			am, _ := newArgumentsMatcher(reporter, variadicType, []interface{}{"SELECT 1"})
+
+			arguments := am.prefixArguments(Call{args: []interface{}{"SELECT 1", []int{1, 2}}})
+
+			Expect(arguments).To(Equal([]interface{}{"SELECT 1", []int(nil)}))
+			Expect(am.matches(arguments)).To(BeTrue())
+		})
+
+		It("keeps the variadic arguments of a call that has fewer than expected", func() {
+			am, _ := newArgumentsMatcher(reporter, variadicType, []interface{}{"SELECT 1", 1, 2})
+
+			arguments := am.prefixArguments(Call{args: []interface{}{"SELECT 1", []int{1}}})
+
+			Expect(arguments).To(Equal([]interface{}{"SELECT 1", []int{1}}))
+			Expect(am.matches(arguments)).To(BeFalse())
+		})
+	})
+
+	Describe("explainMismatch", func() {
+		It("explains the first argument that does not match", func() {
+			am, _ := newArgumentsMatcher(reporter, functionType, []interface{}{match.StringPrefix("/api"), 1})
+
+			Expect(am.explainMismatch([]interface{}{"/v1/users", 2})).
+				To(Equal(`argument 0: expected string with prefix "/api" but got "/v1/users"`))
+		})
+
+		It("explains variadic arguments that do not match", func() {
+			am, _ := newArgumentsMatcher(reporter, variadicType, []interface{}{"SELECT 1", 1, 2})
+
+			Expect(am.explainMismatch([]interface{}{"SELECT 1", []int{1}})).
+				To(Equal("argument 1: expected slice of (1, 2) but got []int{1}"))
+		})
+
+		It("returns an empty string if the arguments match", func() {
+			am, _ := newArgumentsMatcher(reporter, functionType, []interface{}{"hello", 1})
+
+			Expect(am.explainMismatch([]interface{}{"hello", 1})).To(BeEmpty())
+		})
+	})
+
+	Describe("explainArguments", func() {
+		It("explains the mismatch of a matcher that panics", func() {
+			matchers := []match.SupportedKindsMatcher{match.Exactly(1), &panicMatcher{}}
+
+			Expect(explainArguments(matchers, []interface{}{1, 2})).
+				To(Equal("argument 1: expected value matching *mocka.panicMatcher but got 2"))
+		})
+	})
+
+	Describe("explainMismatch", func() {
+		It("returns the panic from inside the predicate of the matcher", func() {
+			matcher := match.AllOf(match.Typed(func(string) bool { panic("ope") }))
+
+			Expect(explainMismatch(matcher, "hi")).To(Equal(`predicate func(string) bool panicked with ope for "hi"`))
+		})
+	})
+
+	Describe("matchArguments", func() {
+		It("returns false if a matcher panics", func() {
+			matchers := []match.SupportedKindsMatcher{&panicMatcher{}}
+
+			Expect(matchArguments(reporter, matchers, []interface{}{1})).To(BeFalse())
+			Expect(reporter.messages).To(BeEmpty())
+		})
+
+		It("reports a panic from inside the predicate of a matcher", func() {
+			matchers := []match.SupportedKindsMatcher{match.Not(match.Typed(func(int) bool { panic("ope") }))}
+
+			Expect(matchArguments(reporter, matchers, []interface{}{1})).To(BeFalse())
+			Expect(reporter.messages).To(Equal([]string{"mocka: predicate func(int) bool panicked with ope for 1"}))
+		})
+
+		It("does not report a panic without a test reporter", func() {
+			matchers := []match.SupportedKindsMatcher{match.Typed(func(int) bool { panic("ope") })}
+
+			Expect(matchArguments(nil, matchers, []interface{}{1})).To(BeFalse())
+		})
+	})
+
+	Describe("callMatches", func() {
+		It("returns whether any, all or none of the calls matched", func() {
+			some := callMatches{matched: []bool{false, true}}
+			all := callMatches{matched: []bool{true, true}}
+			none := callMatches{matched: []bool{false, false}}
+
+			Expect([]bool{some.any(), some.all(), some.none()}).To(Equal([]bool{true, false, false}))
+			Expect([]bool{all.any(), all.all(), all.none()}).To(Equal([]bool{true, true, false}))
+			Expect([]bool{none.any(), none.all(), none.none()}).To(Equal([]bool{false, false, true}))
+		})
+
+		It("returns false for all if there are no calls", func() {
+			Expect(callMatches{}.all()).To(BeFalse())
+		})
+	})
+
+	Describe("calledWithAt", func() {
+		It("is met if the call at the index matched", func() {
+			matches := callMatches{matched: []bool{false, true}}
+
+			Expect(calledWithAt(1).isMet(matches)).To(BeTrue())
+			Expect(calledWithAt(0).isMet(matches)).To(BeFalse())
+			Expect(calledWithAt(2).isMet(matches)).To(BeFalse())
+			Expect(calledWithAt(-1).isMet(matches)).To(BeFalse())
+		})
+	})
+
+	Describe("describe", func() {
+		It("describes the function, the assertion and the arguments", func() {
+			Expect(calledWithAt(1).describe(functionType, []interface{}{"hello", 1})).
+				To(Equal(`func(string, int) (int, error) to be called with ("hello", 1) at call index 1`))
+		})
+	})
+})
@@ -0,0 +1,150 @@
+package mocka
+
+import "time"
+
+// behavior describes how a stub, a set of custom arguments, or a call index
+// responds to a call beyond the static out parameters it returns
+type behavior struct {
+	callThrough bool
+	returnFunc  func([]interface{}) []interface{}
+	panics      bool
+	panicValue  interface{}
+	delayed     bool
+	minDelay    time.Duration
+	maxDelay    time.Duration
+	setArgs     []argumentValue
+	callsArgs   []argumentCall
+	sequence    *Sequence
+}
+
+// clearReturns removes everything other than static out
+// parameters that decides the return values of a call
+func (b *behavior) clearReturns() {
+	b.callThrough = false
+	b.returnFunc = nil
+	b.panics = false
+	b.panicValue = nil
+	b.sequence = nil
+}
+
+// rewind starts the sequence of the behavior over from the first set of out parameters
+func (b *behavior) rewind() {
+	if b.sequence != nil {
+		b.sequence.position = 0
+	}
+}
+
+// setDelay makes the call wait for a random duration between least and most
+func (b *behavior) setDelay(least, most time.Duration) {
+	b.delayed = true
+	b.minDelay = least
+	b.maxDelay = most
+}
+
+// setArg makes the call write the value through the pointer argument at the
+// index, replacing a value previously set for the same index. A new slice is
+// created so snapshots taken of the behavior are not affected.
+func (b *behavior) setArg(av argumentValue) {
+	setArgs := make([]argumentValue, 0, len(b.setArgs)+1)
+	for _, existing := range b.setArgs {
+		if existing.index != av.index {
+			setArgs = append(setArgs, existing)
+		}
+	}
+
+	b.setArgs = append(setArgs, av)
+}
+
+// callArg makes the call call a function argument after the function
+// arguments it was already given. A new slice is created so snapshots taken
+// of the behavior are not affected.
+func (b *behavior) callArg(ac argumentCall) {
+	b.callsArgs = append(append([]argumentCall(nil), b.callsArgs...), ac)
+}
+
+// layer pairs the out parameters of a stub, a set of custom arguments, or a
+// call index with the rest of the behavior it was given
+type layer struct {
+	out []interface{}
+	*behavior
+}
+
+// decidesReturnValues returns true if the layer determines the return
+// values of a call
+func (l layer) decidesReturnValues() bool {
+	return l.out != nil || l.callThrough || l.returnFunc != nil || l.panics || l.sequence != nil
+}
+
+// layers is a collection of layers ordered from the highest priority to the lowest
+type layers []layer
+
+// returning returns the highest priority layer that determines the return
+// values of a call. The lowest priority layer is always returned as a fallback.
+//
+// A layer with a sequence advances it and returns the next out parameters of
+// the sequence. A sequence that ran out falls back to the lower priority layers.
+func (ls layers) returning() layer {
+	for _, l := range ls {
+		if !l.decidesReturnValues() {
+			continue
+		}
+
+		if l.sequence == nil {
+			return l
+		}
+
+		if out, ok := l.sequence.next(); ok {
+			return layer{out: out, behavior: &behavior{}}
+		}
+	}
+
+	return ls[len(ls)-1]
+}
+
+// delay returns a duration between the minimum and maximum delay of the
+// highest priority layer that delays a call, or zero if no layer does
+func (ls layers) delay() time.Duration {
+	for _, l := range ls {
+		if l.delayed {
+			return randomDuration(l.minDelay, l.maxDelay)
+		}
+	}
+
+	return 0
+}
+
+// argumentValues returns the values written through the pointer arguments of
+// a call. For every index the value of the highest priority layer is used.
+func (ls layers) argumentValues() []argumentValue {
+	var values []argumentValue
+	set := map[int]bool{}
+	for _, l := range ls {
+		for _, av := range l.setArgs {
+			if !set[av.index] {
+				set[av.index] = true
+				values = append(values, av)
+			}
+		}
+	}
+
+	return values
+}
+
+// argumentCalls returns the function argument calls of the highest
+// priority layer that calls function arguments
+func (ls layers) argumentCalls() []argumentCall {
+	for _, l := range ls {
+		if len(l.callsArgs) > 0 {
+			return l.callsArgs
+		}
+	}
+
+	return nil
+}
+
+// snapshot returns a copy of the layer that is not affected by later
+// changes to the behavior it was created from
+func (l layer) snapshot() layer {
+	b := *l.behavior
+	return layer{out: l.out, behavior: &b}
+}
@@ -0,0 +1,237 @@
+package mocka
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("behavior", func() {
+	Describe("decidesReturnValues", func() {
+		It("returns false if no return values or call through were given", func() {
+			Expect(layer{behavior: &behavior{}}.decidesReturnValues()).To(BeFalse())
+		})
+
+		It("returns true if return values were given", func() {
+			Expect(layer{out: []interface{}{42}, behavior: &behavior{}}.decidesReturnValues()).To(BeTrue())
+		})
+
+		It("returns true if the layer calls through", func() {
+			Expect(layer{behavior: &behavior{callThrough: true}}.decidesReturnValues()).To(BeTrue())
+		})
+
+		It("returns true if the layer panics", func() {
+			Expect(layer{behavior: &behavior{panics: true}}.decidesReturnValues()).To(BeTrue())
+		})
+
+		It("returns true if the layer has a return function", func() {
+			returnFunc := func([]interface{}) []interface{} { return nil }
+
+			Expect(layer{behavior: &behavior{returnFunc: returnFunc}}.decidesReturnValues()).To(BeTrue())
+		})
+
+		It("returns true if the layer has a sequence", func() {
+			Expect(layer{behavior: &behavior{sequence: &Sequence{}}}.decidesReturnValues()).To(BeTrue())
+		})
+	})
+
+	Describe("clearReturns", func() {
+		It("removes the call through, return function, panic and sequence", func() {
+			b := &behavior{
+				callThrough: true,
+				returnFunc:  func([]interface{}) []interface{} { return nil },
+				panics:      true,
+				panicValue:  "Ope",
+				sequence:    &Sequence{},
+			}
+
+			b.clearReturns()
+
+			Expect(b.callThrough).To(BeFalse())
+			Expect(b.returnFunc).To(BeNil())
+			Expect(b.panics).To(BeFalse())
+			Expect(b.panicValue).To(BeNil())
+			Expect(b.sequence).To(BeNil())
+		})
+	})
+
+	Describe("returning", func() {
+		It("returns the highest priority layer that decides the return values", func() {
+			ls := layers{
+				{behavior: &behavior{}},
+				{behavior: &behavior{callThrough: true}},
+				{out: []interface{}{42}, behavior: &behavior{}},
+			}
+
+			Expect(ls.returning()).To(Equal(ls[1]))
+		})
+
+		It("returns the lowest priority layer if no layer decides the return values", func() {
+			ls := layers{
+				{behavior: &behavior{}},
+				{behavior: &behavior{}},
+			}
+
+			Expect(ls.returning().behavior).To(BeIdenticalTo(ls[1].behavior))
+		})
+
+		It("returns the next out parameters of a layer with a sequence", func() {
+			sequence := &Sequence{values: [][]interface{}{{1}, {2}}}
+			ls := layers{
+				{behavior: &behavior{sequence: sequence}},
+				{out: []interface{}{42}, behavior: &behavior{}},
+			}
+
+			Expect(ls.returning().out).To(Equal([]interface{}{1}))
+			Expect(ls.returning().out).To(Equal([]interface{}{2}))
+		})
+
+		It("falls back to the lower priority layers once a sequence runs out", func() {
+			sequence := &Sequence{values: [][]interface{}{{1}}, policy: fallBackToDefault}
+			ls := layers{
+				{behavior: &behavior{sequence: sequence}},
+				{out: []interface{}{42}, behavior: &behavior{}},
+			}
+
+			Expect(ls.returning().out).To(Equal([]interface{}{1}))
+			Expect(ls.returning().out).To(Equal([]interface{}{42}))
+		})
+	})
+
+	Describe("snapshot", func() {
+		It("returns a copy of the layer that is not affected by changes to the behavior", func() {
+			b := &behavior{panics: true, panicValue: "Ope"}
+			l := layer{out: []interface{}{42}, behavior: b}
+
+			snapshot := l.snapshot()
+			b.clearReturns()
+
+			Expect(snapshot.out).To(Equal([]interface{}{42}))
+			Expect(snapshot.panics).To(BeTrue())
+			Expect(snapshot.panicValue).To(Equal("Ope"))
+		})
+	})
+
+	Describe("setDelay", func() {
+		It("sets the minimum and maximum delay", func() {
+			b := &behavior{}
+
+			b.setDelay(time.Millisecond, time.Second)
+
+			Expect(b.delayed).To(BeTrue())
+			Expect(b.minDelay).To(Equal(time.Millisecond))
+			Expect(b.maxDelay).To(Equal(time.Second))
+		})
+
+		It("is not undone by clearing the return values", func() {
+			b := &behavior{}
+			b.setDelay(time.Second, time.Second)
+
+			b.clearReturns()
+
+			Expect(b.delayed).To(BeTrue())
+		})
+	})
+
+	Describe("delay", func() {
+		It("returns zero if no layer delays the call", func() {
+			ls := layers{{behavior: &behavior{}}, {behavior: &behavior{}}}
+
+			Expect(ls.delay()).To(BeZero())
+		})
+
+		It("returns the delay of the highest priority layer that delays the call", func() {
+			low := &behavior{}
+			low.setDelay(time.Second, time.Second)
+			high := &behavior{}
+			high.setDelay(time.Millisecond, time.Millisecond)
+			ls := layers{{behavior: &behavior{}}, {behavior: high}, {behavior: low}}
+
+			Expect(ls.delay()).To(Equal(time.Millisecond))
+		})
+	})
+	Describe("setArg", func() {
+		It("replaces the value previously set for the same index", func() {
+			b := &behavior{}
+
+			b.setArg(argumentValue{index: 0, value: 1})
+			b.setArg(argumentValue{index: 1, value: 2})
+			b.setArg(argumentValue{index: 0, value: 3})
+
+			Expect(b.setArgs).To(Equal([]argumentValue{{index: 1, value: 2}, {index: 0, value: 3}}))
+		})
+
+		It("does not affect snapshots", func() {
+			b := &behavior{}
+			b.setArg(argumentValue{index: 0, value: 1})
+			snapshot := layer{behavior: b}.snapshot()
+
+			b.setArg(argumentValue{index: 0, value: 2})
+
+			Expect(snapshot.setArgs).To(Equal([]argumentValue{{index: 0, value: 1}}))
+		})
+	})
+
+	Describe("argumentValues", func() {
+		It("returns the value of the highest priority layer for every index", func() {
+			high := &behavior{}
+			high.setArg(argumentValue{index: 1, value: "high"})
+			low := &behavior{}
+			low.setArg(argumentValue{index: 0, value: "low"})
+			low.setArg(argumentValue{index: 1, value: "low"})
+			ls := layers{{behavior: high}, {behavior: &behavior{}}, {behavior: low}}
+
+			Expect(ls.argumentValues()).To(Equal([]argumentValue{{index: 1, value: "high"}, {index: 0, value: "low"}}))
+		})
+	})
+	Describe("callArg", func() {
+		It("adds the function argument call after the previous ones", func() {
+			b := &behavior{}
+
+			b.callArg(argumentCall{index: 1})
+			b.callArg(argumentCall{index: 0, async: true})
+
+			Expect(b.callsArgs).To(Equal([]argumentCall{{index: 1}, {index: 0, async: true}}))
+		})
+
+		It("does not affect snapshots", func() {
+			b := &behavior{}
+			b.callArg(argumentCall{index: 1})
+			snapshot := layer{behavior: b}.snapshot()
+
+			b.callArg(argumentCall{index: 0})
+
+			Expect(snapshot.callsArgs).To(Equal([]argumentCall{{index: 1}}))
+		})
+	})
+
+	Describe("argumentCalls", func() {
+		It("returns the function argument calls of the highest priority layer that calls function arguments", func() {
+			high := &behavior{}
+			high.callArg(argumentCall{index: 1})
+			low := &behavior{}
+			low.callArg(argumentCall{index: 0})
+			ls := layers{{behavior: &behavior{}}, {behavior: high}, {behavior: low}}
+
+			Expect(ls.argumentCalls()).To(Equal([]argumentCall{{index: 1}}))
+		})
+
+		It("returns nil if no layer calls function arguments", func() {
+			Expect(layers{{behavior: &behavior{}}}.argumentCalls()).To(BeNil())
+		})
+	})
+	Describe("rewind", func() {
+		It("starts the sequence over", func() {
+			b := &behavior{sequence: &Sequence{position: 3}}
+
+			b.rewind()
+
+			Expect(b.sequence.position).To(BeZero())
+		})
+
+		It("does nothing without a sequence", func() {
+			Expect(func() { (&behavior{}).rewind() }).ToNot(Panic())
+		})
+	})
+})
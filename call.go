@@ -1,9 +1,31 @@
 package mocka
 
+import (
+	"sync/atomic"
+	"time"
+)
+
+// callSequence is the sequence number of the last call started on any stub
+var callSequence uint64
+
 // Call represents the information for a specific call invocation of the stubbed function
 type Call struct {
-	args []interface{}
-	out  []interface{}
+	args       []interface{}
+	out        []interface{}
+	panicked   bool
+	panicValue interface{}
+	sequence   uint64
+	timestamp  time.Time
+	caller     string
+	stack      string
+	goroutine  uint64
+	delay      time.Duration
+	callbacks  *callbackResults
+}
+
+// nextCallSequence returns the next sequence number to stamp a starting call with
+func nextCallSequence() uint64 {
+	return atomic.AddUint64(&callSequence, 1)
 }
 
 // Arguments returns the arguments that stub was called with.
@@ -15,3 +37,72 @@ func (c Call) Arguments() []interface{} {
 func (c Call) ReturnValues() []interface{} {
 	return c.out
 }
+
+// Panicked returns true if the stubbed implementation panicked for this call.
+func (c Call) Panicked() bool {
+	return c.panicked
+}
+
+// PanicValue returns the value the stubbed implementation panicked with.
+func (c Call) PanicValue() interface{} {
+	return c.panicValue
+}
+
+// Sequence returns the global sequence number of the call. Sequence numbers
+// increase monotonically across all stubs, so comparing them tells which of
+// two calls happened first.
+func (c Call) Sequence() uint64 {
+	return c.sequence
+}
+
+// Timestamp returns the time the stub was called.
+func (c Call) Timestamp() time.Time {
+	return c.timestamp
+}
+
+// Caller returns the file:line the stub was called from. Frames of the reflect
+// package and mocka itself are skipped.
+func (c Call) Caller() string {
+	return c.caller
+}
+
+// Stack returns the stack trace of the goroutine that called the stub. The stack
+// is only captured if CaptureStack was called on the stub; otherwise it is empty.
+func (c Call) Stack() string {
+	return c.stack
+}
+
+// GoroutineID returns the identifier of the goroutine that called the stub.
+func (c Call) GoroutineID() uint64 {
+	return c.goroutine
+}
+
+// Delay returns how long the stub waited before returning, as measured by the
+// stub's clock. It is zero unless the stub was configured with a delay.
+func (c Call) Delay() time.Duration {
+	return c.delay
+}
+
+// CallbackResults returns the return values of the function arguments the stub
+// called for this call, in the order the calls completed. The return values of
+// asynchronous calls are included once they complete.
+func (c Call) CallbackResults() [][]interface{} {
+	if c.callbacks == nil {
+		return nil
+	}
+
+	return c.callbacks.get()
+}
+
+// WaitForCallbacks blocks until the asynchronous function argument calls the
+// stub made for this call complete or the timeout elapses, so their return
+// values can be asserted on with CallbackResults.
+//
+// WaitForCallbacks returns true if the calls completed; otherwise false.
+func (c Call) WaitForCallbacks(timeout time.Duration) bool {
+	if c.callbacks == nil {
+		return true
+	}
+
+	return c.callbacks.wait(timeout)
+}
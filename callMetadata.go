@@ -0,0 +1,73 @@
+package mocka
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// maxCallerDepth is the maximum number of frames inspected to find the caller of a stub
+const maxCallerDepth = 32
+
+// sourceDir is the directory of mocka's source files used to skip mocka's own frames
+var sourceDir = func() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Dir(file)
+}()
+
+// callerLocation returns the file:line of the first frame on the current goroutine's
+// stack that belongs to neither the reflect package nor mocka
+func callerLocation() string {
+	pcs := make([]uintptr, maxCallerDepth)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+
+	for {
+		frame, more := frames.Next()
+		if !isInternalFrame(frame) {
+			return fmt.Sprintf("%v:%v", frame.File, frame.Line)
+		}
+
+		if !more {
+			return ""
+		}
+	}
+}
+
+// isInternalFrame returns true if the frame belongs to the reflect package, the
+// runtime or one of mocka's source files
+func isInternalFrame(frame runtime.Frame) bool {
+	if strings.HasPrefix(frame.Function, "reflect.") || strings.HasPrefix(frame.Function, "runtime.") {
+		return true
+	}
+
+	return filepath.Dir(frame.File) == sourceDir && !strings.HasSuffix(frame.File, "_test.go")
+}
+
+// currentStack returns the formatted stack trace of the current goroutine
+func currentStack() string {
+	buf := make([]byte, 1024)
+	for {
+		n := runtime.Stack(buf, false)
+		if n < len(buf) {
+			return string(buf[:n])
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+}
+
+// currentGoroutineID returns the identifier of the current goroutine
+// parsed from the header of its stack trace, "goroutine 42 [running]:"
+func currentGoroutineID() uint64 {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+	buf = bytes.TrimPrefix(buf, []byte("goroutine "))
+	if i := bytes.IndexByte(buf, ' '); i >= 0 {
+		buf = buf[:i]
+	}
+
+	id, _ := strconv.ParseUint(string(buf), 10, 64)
+	return id
+}
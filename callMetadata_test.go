@@ -0,0 +1,83 @@
+package mocka
+
+import (
+	"runtime"
+	"strconv"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("callMetadata", func() {
+	Describe("callerLocation", func() {
+		It("returns the file and line of the first frame outside of mocka", func() {
+			_, file, line, _ := runtime.Caller(0)
+			location := callerLocation()
+
+			Expect(location).To(Equal(file + ":" + strconv.Itoa(line+1)))
+		})
+
+		It("skips the frames of the reflect package and mocka", func() {
+			fn := func(str string) int {
+				return len(str)
+			}
+			stub := Function(GinkgoT(), &fn, 42)
+			defer stub.Restore()
+
+			_, file, line, _ := runtime.Caller(0)
+			_ = fn("hello")
+
+			Expect(stub.GetFirstCall().Caller()).To(Equal(file + ":" + strconv.Itoa(line+1)))
+		})
+	})
+
+	Describe("isInternalFrame", func() {
+		It("returns true for frames of the reflect package", func() {
+			Expect(isInternalFrame(runtime.Frame{Function: "reflect.Value.call"})).To(BeTrue())
+		})
+
+		It("returns true for frames of the runtime", func() {
+			Expect(isInternalFrame(runtime.Frame{Function: "runtime.goexit"})).To(BeTrue())
+		})
+
+		It("returns true for frames of mocka's source files", func() {
+			Expect(isInternalFrame(runtime.Frame{File: sourceDir + "/stub.go"})).To(BeTrue())
+		})
+
+		It("returns false for frames of mocka's test files", func() {
+			Expect(isInternalFrame(runtime.Frame{File: sourceDir + "/stub_test.go"})).To(BeFalse())
+		})
+
+		It("returns false for frames outside of mocka", func() {
+			Expect(isInternalFrame(runtime.Frame{Function: "main.main", File: "/app/main.go"})).To(BeFalse())
+		})
+	})
+
+	Describe("currentStack", func() {
+		It("returns the stack trace of the current goroutine", func() {
+			stack := currentStack()
+
+			Expect(stack).To(HavePrefix("goroutine "))
+			Expect(stack).To(ContainSubstring("callMetadata_test.go"))
+		})
+	})
+
+	Describe("currentGoroutineID", func() {
+		It("returns the identifier of the current goroutine", func() {
+			stack := currentStack()
+			expected := strings.Fields(stack)[1]
+
+			Expect(strconv.FormatUint(currentGoroutineID(), 10)).To(Equal(expected))
+		})
+
+		It("returns different identifiers for different goroutines", func() {
+			ids := make(chan uint64)
+			go func() {
+				ids <- currentGoroutineID()
+			}()
+
+			Expect(<-ids).ToNot(Equal(currentGoroutineID()))
+		})
+	})
+})
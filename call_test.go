@@ -1,6 +1,8 @@
 package mocka
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -31,4 +33,95 @@ var _ = Describe("call", func() {
 			Expect(result).To(Equal([]interface{}{40, nil}))
 		})
 	})
+
+	Describe("Panicked", func() {
+		It("returns whether the call panicked", func() {
+			Expect(Call{}.Panicked()).To(BeFalse())
+			Expect(Call{panicked: true}.Panicked()).To(BeTrue())
+		})
+	})
+
+	Describe("PanicValue", func() {
+		It("returns the value the call panicked with", func() {
+			testCall := &Call{
+				args:       []interface{}{42, "hello"},
+				panicked:   true,
+				panicValue: "Ope",
+			}
+
+			Expect(testCall.PanicValue()).To(Equal("Ope"))
+		})
+	})
+
+	Describe("Sequence", func() {
+		It("returns the global sequence number of the call", func() {
+			Expect(Call{sequence: 42}.Sequence()).To(Equal(uint64(42)))
+		})
+	})
+
+	Describe("nextCallSequence", func() {
+		It("returns monotonically increasing sequence numbers", func() {
+			first := nextCallSequence()
+			second := nextCallSequence()
+
+			Expect(second).To(BeNumerically(">", first))
+		})
+	})
+
+	Describe("Timestamp", func() {
+		It("returns the time the stub was called", func() {
+			now := time.Now()
+
+			Expect(Call{timestamp: now}.Timestamp()).To(Equal(now))
+		})
+	})
+
+	Describe("Caller", func() {
+		It("returns the location the stub was called from", func() {
+			Expect(Call{caller: "main.go:42"}.Caller()).To(Equal("main.go:42"))
+		})
+	})
+
+	Describe("Stack", func() {
+		It("returns the stack trace of the call", func() {
+			Expect(Call{}.Stack()).To(BeEmpty())
+			Expect(Call{stack: "goroutine 1 [running]:"}.Stack()).To(Equal("goroutine 1 [running]:"))
+		})
+	})
+
+	Describe("GoroutineID", func() {
+		It("returns the identifier of the goroutine that called the stub", func() {
+			Expect(Call{goroutine: 7}.GoroutineID()).To(Equal(uint64(7)))
+		})
+	})
+
+	Describe("Delay", func() {
+		It("returns how long the stub waited before returning", func() {
+			Expect(Call{}.Delay()).To(BeZero())
+			Expect(Call{delay: time.Second}.Delay()).To(Equal(time.Second))
+		})
+	})
+	Describe("CallbackResults", func() {
+		It("returns the return values of the function arguments the stub called", func() {
+			cr := &callbackResults{}
+			cr.append([]interface{}{42, nil})
+
+			Expect(Call{}.CallbackResults()).To(BeNil())
+			Expect(Call{callbacks: cr}.CallbackResults()).To(Equal([][]interface{}{{42, nil}}))
+		})
+	})
+
+	Describe("WaitForCallbacks", func() {
+		It("returns true if the stub made no asynchronous calls or they completed", func() {
+			cr := newCallbackResults()
+			close(cr.done)
+
+			Expect(Call{}.WaitForCallbacks(time.Millisecond)).To(BeTrue())
+			Expect(Call{callbacks: cr}.WaitForCallbacks(time.Millisecond)).To(BeTrue())
+		})
+
+		It("returns false if the asynchronous calls did not complete before the timeout", func() {
+			Expect(Call{callbacks: newCallbackResults()}.WaitForCallbacks(time.Millisecond)).To(BeFalse())
+		})
+	})
 })
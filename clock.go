@@ -0,0 +1,36 @@
+package mocka
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Clock is an interface used by stubs to tell the time and to delay calls.
+// It is satisfied by the system clock used by default, but can be replaced
+// with a virtual clock so tests do not have to wait in real time.
+type Clock interface {
+	Now() time.Time
+	Sleep(time.Duration)
+}
+
+// systemClock is the default clock that uses the time package
+type systemClock struct{}
+
+// Now returns the current local time
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// Sleep pauses the current goroutine for at least the duration
+func (systemClock) Sleep(d time.Duration) {
+	time.Sleep(d)
+}
+
+// randomDuration returns a random duration between least and most inclusive
+func randomDuration(least, most time.Duration) time.Duration {
+	if most <= least {
+		return least
+	}
+
+	return least + time.Duration(rand.Int63n(int64(most-least)+1))
+}
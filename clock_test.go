@@ -0,0 +1,42 @@
+package mocka
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("clock", func() {
+	Describe("systemClock", func() {
+		It("returns the current time", func() {
+			before := time.Now()
+
+			Expect(systemClock{}.Now()).To(BeTemporally(">=", before))
+		})
+
+		It("sleeps for at least the duration", func() {
+			before := time.Now()
+
+			systemClock{}.Sleep(time.Millisecond)
+
+			Expect(time.Since(before)).To(BeNumerically(">=", time.Millisecond))
+		})
+	})
+
+	Describe("randomDuration", func() {
+		It("returns the minimum if it is not less than the maximum", func() {
+			Expect(randomDuration(time.Second, time.Second)).To(Equal(time.Second))
+			Expect(randomDuration(time.Second, time.Millisecond)).To(Equal(time.Second))
+		})
+
+		It("returns a duration between the minimum and the maximum inclusive", func() {
+			for i := 0; i < 100; i++ {
+				d := randomDuration(time.Millisecond, 3*time.Millisecond)
+
+				Expect(d).To(BeNumerically(">=", time.Millisecond))
+				Expect(d).To(BeNumerically("<=", 3*time.Millisecond))
+			}
+		})
+	})
+})
@@ -2,6 +2,7 @@ package mocka
 
 import (
 	"reflect"
+	"time"
 
 	"github.com/MonsantoCo/mocka/v2/match"
 )
@@ -14,13 +15,17 @@ func newCustomArguments(stub *Stub, arguments []interface{}) *CustomArguments {
 		return nil
 	}
 
+	if !validateMatchers(stub.testReporter, arguments) {
+		return nil
+	}
+
 	matchers := getMatchers(functionType, arguments)
 	if matchers == nil {
 		reportInvalidArguments(stub.testReporter, functionType, arguments)
 		return nil
 	}
 
-	return &CustomArguments{stub: stub, callCount: 0, argMatchers: matchers}
+	return &CustomArguments{stub: stub, callCount: 0, arguments: arguments, argMatchers: matchers}
 }
 
 // isArgumentLengthValid returns whether or not the length of the provided arguments
@@ -74,32 +79,36 @@ func getMatchers(functionType reflect.Type, arguments []interface{}) []match.Sup
 // getMatcher returns a matcher for the provided type and value
 func getMatcher(value interface{}, valueType reflect.Type) (match.SupportedKindsMatcher, bool) {
 	if matcher, ok := value.(match.SupportedKindsMatcher); ok {
-		if _, ok := matcher.SupportedKinds()[valueType.Kind()]; !ok {
+		if !match.Supports(matcher, valueType) {
 			return nil, false
 		}
 
 		return matcher, true
 	}
 
-	if !areTypeAndValueEquivalent(valueType, value) {
+	converted, ok := convertValue(valueType, value)
+	if !ok {
 		return nil, false
 	}
 
-	if value == nil {
+	if converted == nil {
 		return match.Nil(), true
 	}
 
-	return match.Exactly(value), true
+	return match.Exactly(converted), true
 }
 
 // CustomArguments represents a unique set of custom arguments in which
 // the stubbed function will have different return values for
 type CustomArguments struct {
 	stub        *Stub
+	arguments   []interface{}
 	argMatchers []match.SupportedKindsMatcher
 	out         []interface{}
 	onCalls     []*OnCall
+	callIndex   int
 	callCount   int
+	behavior
 }
 
 // Return sets the return values for this set of custom arguments
@@ -113,6 +122,138 @@ func (ca *CustomArguments) Return(returnValues ...interface{}) {
 	}
 
 	ca.out = returnValues
+	ca.clearReturns()
+}
+
+// ReturnError makes the stub return the error as its trailing error result and
+// the zero values for every other out parameter for this set of custom arguments
+func (ca *CustomArguments) ReturnError(err error) {
+	ca.stub.lock.Lock()
+	defer ca.stub.lock.Unlock()
+
+	out, ok := zeroValuesWithError(ca.stub.toType(), err)
+	if !ok {
+		reportMissingErrorResult(ca.stub.testReporter, ca.stub.toType())
+		return
+	}
+
+	ca.out = out
+	ca.clearReturns()
+}
+
+// ReturnFunc assigns a function that computes the out parameters
+// for this set of custom arguments from the arguments of each call
+func (ca *CustomArguments) ReturnFunc(returnFunc func(arguments []interface{}) []interface{}) {
+	ca.stub.lock.Lock()
+	defer ca.stub.lock.Unlock()
+
+	ca.out = nil
+	ca.clearReturns()
+	ca.returnFunc = returnFunc
+}
+
+// ReturnsInOrder makes the stub return the sets of out parameters in order,
+// one set per call, for this set of custom arguments. The returned sequence
+// describes what is returned once they run out.
+func (ca *CustomArguments) ReturnsInOrder(values ...[]interface{}) *Sequence {
+	ca.stub.lock.Lock()
+	defer ca.stub.lock.Unlock()
+
+	sequence := newSequence(ca.stub, values)
+	if validateSequence(ca.stub.testReporter, ca.stub.toType(), values) {
+		ca.out = nil
+		ca.clearReturns()
+		ca.sequence = sequence
+	}
+
+	return sequence
+}
+
+// Panics makes the stub panic with the provided value
+// for this set of custom arguments
+func (ca *CustomArguments) Panics(value interface{}) {
+	ca.stub.lock.Lock()
+	defer ca.stub.lock.Unlock()
+
+	ca.out = nil
+	ca.clearReturns()
+	ca.panics = true
+	ca.panicValue = value
+}
+
+// CallThrough makes the stub call the original function for this
+// set of custom arguments
+func (ca *CustomArguments) CallThrough() {
+	ca.stub.lock.Lock()
+	defer ca.stub.lock.Unlock()
+
+	if !ca.stub.validateCallThrough() {
+		return
+	}
+
+	ca.out = nil
+	ca.clearReturns()
+	ca.callThrough = true
+}
+
+// Delay makes the stub wait for the duration before returning
+// for this set of custom arguments. The wait goes through the stub's clock.
+func (ca *CustomArguments) Delay(d time.Duration) {
+	ca.DelayBetween(d, d)
+}
+
+// DelayBetween makes the stub wait for a random duration between least
+// and most, inclusive, before returning for this set of custom arguments.
+func (ca *CustomArguments) DelayBetween(least, most time.Duration) {
+	ca.stub.lock.Lock()
+	defer ca.stub.lock.Unlock()
+
+	if validateDelay(ca.stub.testReporter, least, most) {
+		ca.setDelay(least, most)
+	}
+}
+
+// SetArg makes the stub write the value through the pointer argument at the
+// index before returning for this set of custom arguments. Indexes at or after a
+// variadic argument address its elements.
+func (ca *CustomArguments) SetArg(index int, value interface{}) {
+	ca.stub.lock.Lock()
+	defer ca.stub.lock.Unlock()
+
+	av := argumentValue{index: index, value: value}
+	if validateArgumentValue(ca.stub.testReporter, ca.stub.toType(), av) {
+		ca.setArg(av)
+	}
+}
+
+// CallsArg makes the stub call the function argument at the index with the
+// provided arguments before returning for this set of custom arguments. Calling
+// CallsArg again adds another call that is made after the previous ones. The
+// return values of the function argument are captured by the call.
+func (ca *CustomArguments) CallsArg(index int, args ...interface{}) {
+	ca.addArgumentCall(argumentCall{index: index, args: args})
+}
+
+// CallsArgAsync makes the stub call the function argument at the index with the
+// provided arguments on a new goroutine for this set of custom arguments, so
+// the stub can return before the function argument completes.
+func (ca *CustomArguments) CallsArgAsync(index int, args ...interface{}) {
+	ca.addArgumentCall(argumentCall{index: index, args: args, async: true})
+}
+
+// addArgumentCall validates the function argument call before adding it
+func (ca *CustomArguments) addArgumentCall(ac argumentCall) {
+	ca.stub.lock.Lock()
+	defer ca.stub.lock.Unlock()
+
+	if validateArgumentCall(ca.stub.testReporter, ca.stub.toType(), ac) {
+		ca.callArg(ac)
+	}
+}
+
+// layer returns the layer describing the behavior for this set of custom arguments
+func (ca *CustomArguments) layer() layer {
+	return layer{out: ca.out, behavior: &ca.behavior}
 }
 
 // OnCall returns an interface that allows for changing the
@@ -154,20 +295,21 @@ func (ca *CustomArguments) OnThirdCall() *OnCall {
 	return ca.OnCall(2)
 }
 
-// isMatch returns false if any of the argument matchers return false or
-// if there is a panic from inside a matcher; otherwise true
-func (ca *CustomArguments) isMatch(arguments []interface{}) (isMatch bool) {
-	defer func() {
-		if r := recover(); r != nil {
-			isMatch = false
-		}
-	}()
+// Expect returns an expectation for how many times the original function is
+// called with this set of custom arguments. The expectation defaults to being
+// called at least once and is checked when Verify is called on the stub.
+func (ca *CustomArguments) Expect() *Expectation {
+	ca.stub.lock.Lock()
+	defer ca.stub.lock.Unlock()
 
-	for i, arg := range arguments {
-		if !ca.argMatchers[i].Match(arg) {
-			return false
-		}
-	}
+	e := newExpectation(ca.stub, ca)
+	ca.stub.expectations = append(ca.stub.expectations, e)
+	return e
+}
 
-	return true
+// isMatch returns false if any of the argument matchers return false or
+// if there is a panic from inside a matcher; otherwise true. A panic from
+// inside the predicate of a matcher fails the test.
+func (ca *CustomArguments) isMatch(arguments []interface{}) bool {
+	return matchArguments(ca.stub.testReporter, ca.argMatchers, arguments)
 }
@@ -3,6 +3,7 @@ package mocka
 import (
 	"errors"
 	"reflect"
+	"time"
 
 	"github.com/MonsantoCo/mocka/v2/match"
 	. "github.com/onsi/ginkgo"
@@ -52,7 +53,40 @@ var _ = Describe("CustomArguments", func() {
 			})
 
 			Expect(failTestReporter.messages).To(Equal([]string{
-				"mocka: expected arguments of type (string, int), but received (*anything, *lengthOf)",
+				"mocka: expected arguments of type (string, int), but received (anything, value with length 10): argument 1: value with length 10 does not support int",
+			}))
+		})
+
+		It("reports an error if a string matching matcher is used for an argument that is not a string or fmt.Stringer", func() {
+			stub.testReporter = failTestReporter
+
+			ca := newCustomArguments(stub, []interface{}{"", match.StringMatching("^1")})
+
+			Expect(ca).To(BeNil())
+			Expect(failTestReporter.messages).To(Equal([]string{
+				`mocka: expected arguments of type (string, int), but received (string, string matching "^1"): argument 1: string matching "^1" does not support int`,
+			}))
+		})
+
+		It("reports an error for an invalid predicate instead of the argument types", func() {
+			stub.testReporter = failTestReporter
+
+			ca := newCustomArguments(stub, []interface{}{match.Func(func(int) string { return "" }), 1})
+
+			Expect(ca).To(BeNil())
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: invalid matcher for argument 0: expected a predicate of type func(T) bool, but received func(int) string",
+			}))
+		})
+
+		It("reports an error if a provided matcher is not valid", func() {
+			stub.testReporter = failTestReporter
+
+			ca := newCustomArguments(stub, []interface{}{match.StringMatching("["), 1})
+
+			Expect(ca).To(BeNil())
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: invalid matcher for argument 0: error parsing regexp: missing closing ]: `[`",
 			}))
 		})
 
@@ -72,6 +106,7 @@ var _ = Describe("CustomArguments", func() {
 			Expect(ca).ToNot(BeNil())
 			Expect(*ca).To(Equal(CustomArguments{
 				stub:        stub,
+				arguments:   []interface{}{"hi", match.IntGreaterThan(10)},
 				argMatchers: []match.SupportedKindsMatcher{match.Exactly("hi"), match.IntGreaterThan(10)},
 			}))
 		})
@@ -112,6 +147,7 @@ var _ = Describe("CustomArguments", func() {
 			Expect(ca).ToNot(BeNil())
 			Expect(*ca).To(Equal(CustomArguments{
 				stub:        stub,
+				arguments:   []interface{}{nil},
 				argMatchers: []match.SupportedKindsMatcher{match.Nil()},
 			}))
 		})
@@ -134,6 +170,7 @@ var _ = Describe("CustomArguments", func() {
 				Expect(ca).ToNot(BeNil())
 				Expect(*ca).To(Equal(CustomArguments{
 					stub:        stub,
+					arguments:   []interface{}{"hi"},
 					argMatchers: []match.SupportedKindsMatcher{match.Exactly("hi"), match.Nil()},
 				}))
 			})
@@ -143,7 +180,8 @@ var _ = Describe("CustomArguments", func() {
 
 				Expect(ca).ToNot(BeNil())
 				Expect(*ca).To(Equal(CustomArguments{
-					stub: stub,
+					stub:      stub,
+					arguments: []interface{}{"hi", nil, "A", match.Anything()},
 					argMatchers: []match.SupportedKindsMatcher{
 						match.Exactly("hi"),
 						match.SliceOf(match.Nil(), match.Exactly("A"), match.Anything())},
@@ -156,7 +194,7 @@ var _ = Describe("CustomArguments", func() {
 				_ = newCustomArguments(stub, []interface{}{"hi", match.ElementsContaining("A")})
 
 				Expect(failTestReporter.messages).To(Equal([]string{
-					"mocka: expected arguments of type (string, ...), but received (string, *elementsContaining)",
+					`mocka: expected arguments of type (string, ...interface {}), but received (string, elements containing ("A")): argument 1: elements containing ("A") does not support interface {}`,
 				}))
 			})
 		})
@@ -193,6 +231,210 @@ var _ = Describe("CustomArguments", func() {
 		})
 	})
 
+	Describe("ReturnError", func() {
+		It("returns the error with the zero values for the other out parameters", func() {
+			ca := &CustomArguments{stub: stub}
+			ca.Panics("Ope")
+
+			ca.ReturnError(errors.New("Ope"))
+
+			Expect(ca.out).To(Equal([]interface{}{0, errors.New("Ope")}))
+			Expect(ca.panics).To(BeFalse())
+		})
+
+		It("reports an error if the function does not return an error as its last out parameter", func() {
+			var count func() int
+			stub.functionPtr = &count
+			stub.testReporter = failTestReporter
+			ca := &CustomArguments{stub: stub}
+
+			ca.ReturnError(errors.New("Ope"))
+
+			Expect(ca.out).To(BeNil())
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: expected func() (int) to have an error as its last return value to use ReturnError",
+			}))
+		})
+	})
+
+	Describe("ReturnFunc", func() {
+		It("clears the out parameters and assigns the return function", func() {
+			ca := &CustomArguments{stub: stub, out: []interface{}{42, nil}}
+
+			ca.ReturnFunc(func([]interface{}) []interface{} {
+				return []interface{}{1, nil}
+			})
+
+			Expect(ca.out).To(BeNil())
+			Expect(ca.returnFunc(nil)).To(Equal([]interface{}{1, nil}))
+		})
+	})
+
+	Describe("Panics", func() {
+		It("clears the out parameters and panics with the provided value", func() {
+			ca := &CustomArguments{stub: stub, out: []interface{}{42, nil}}
+
+			ca.Panics("Ope")
+
+			Expect(ca.out).To(BeNil())
+			Expect(ca.panics).To(BeTrue())
+			Expect(ca.panicValue).To(Equal("Ope"))
+		})
+	})
+
+	Describe("Expect", func() {
+		It("appends a new expectation for the custom arguments to the stub", func() {
+			ca := &CustomArguments{stub: stub}
+
+			e := ca.Expect()
+
+			Expect(stub.expectations).To(Equal([]*Expectation{e}))
+			Expect(e.customArgs).To(Equal(ca))
+		})
+	})
+
+	Describe("Delay", func() {
+		It("delays the call by the duration", func() {
+			ca := &CustomArguments{stub: stub}
+
+			ca.Delay(time.Second)
+
+			Expect(ca.delayed).To(BeTrue())
+			Expect(ca.minDelay).To(Equal(time.Second))
+			Expect(ca.maxDelay).To(Equal(time.Second))
+		})
+	})
+
+	Describe("DelayBetween", func() {
+		It("delays the call by a duration between the minimum and maximum", func() {
+			ca := &CustomArguments{stub: stub}
+
+			ca.DelayBetween(time.Millisecond, time.Second)
+
+			Expect(ca.minDelay).To(Equal(time.Millisecond))
+			Expect(ca.maxDelay).To(Equal(time.Second))
+		})
+
+		It("reports an invalid delay", func() {
+			stub.testReporter = failTestReporter
+			ca := &CustomArguments{stub: stub}
+
+			ca.DelayBetween(time.Second, time.Millisecond)
+
+			Expect(ca.delayed).To(BeFalse())
+			Expect(failTestReporter.messages).To(HaveLen(1))
+		})
+	})
+
+	Describe("SetArg", func() {
+		It("reports the value because the argument is not a pointer", func() {
+			stub.testReporter = failTestReporter
+			ca := &CustomArguments{stub: stub}
+
+			ca.SetArg(1, 42)
+
+			Expect(ca.setArgs).To(BeEmpty())
+			Expect(failTestReporter.messages).To(ConsistOf("mocka: expected argument 1 to be a pointer to set its value, but it is of type int"))
+		})
+
+		It("sets the value for a pointer argument", func() {
+			fn := func(str string, n *int) (int, error) {
+				return 0, nil
+			}
+			stub.functionPtr = &fn
+			ca := &CustomArguments{stub: stub}
+
+			ca.SetArg(1, 42)
+
+			Expect(ca.setArgs).To(Equal([]argumentValue{{index: 1, value: 42}}))
+		})
+	})
+
+	Describe("CallsArg", func() {
+		It("reports the function argument call because the argument is not a function", func() {
+			stub.testReporter = failTestReporter
+			ca := &CustomArguments{stub: stub}
+
+			ca.CallsArg(0, "hello")
+
+			Expect(ca.callsArgs).To(BeEmpty())
+			Expect(failTestReporter.messages).To(ConsistOf("mocka: expected argument 0 to be a function to call it, but it is of type string"))
+		})
+
+		It("adds synchronous and asynchronous function argument calls", func() {
+			fn := func(str string, callback func(int)) (int, error) {
+				return 0, nil
+			}
+			stub.functionPtr = &fn
+			ca := &CustomArguments{stub: stub}
+
+			ca.CallsArg(1, 1)
+			ca.CallsArgAsync(1, 2)
+
+			Expect(ca.callsArgs).To(Equal([]argumentCall{{index: 1, args: []interface{}{1}}, {index: 1, args: []interface{}{2}, async: true}}))
+		})
+	})
+
+	Describe("ReturnsInOrder", func() {
+		It("clears the out parameters and returns the values in order", func() {
+			ca := &CustomArguments{stub: stub, out: []interface{}{42, nil}}
+
+			sequence := ca.ReturnsInOrder([]interface{}{1, nil}, []interface{}{2, nil})
+
+			Expect(ca.out).To(BeNil())
+			Expect(ca.sequence).To(BeIdenticalTo(sequence))
+			Expect(sequence.values).To(Equal([][]interface{}{{1, nil}, {2, nil}}))
+		})
+
+		It("reports invalid out parameters", func() {
+			stub.testReporter = failTestReporter
+			ca := &CustomArguments{stub: stub, out: []interface{}{42, nil}}
+
+			ca.ReturnsInOrder([]interface{}{1, nil}, []interface{}{"2"})
+
+			Expect(ca.sequence).To(BeNil())
+			Expect(ca.out).To(Equal([]interface{}{42, nil}))
+			Expect(failTestReporter.messages).To(HaveLen(1))
+		})
+	})
+
+	Describe("CallThrough", func() {
+		BeforeEach(func() {
+			stub.originalFunc = fn
+		})
+
+		It("clears the out parameters and calls through to the original function", func() {
+			ca := &CustomArguments{stub: stub, out: []interface{}{42, nil}}
+
+			ca.CallThrough()
+
+			Expect(ca.out).To(BeNil())
+			Expect(ca.callThrough).To(BeTrue())
+		})
+
+		It("is undone by setting the return values", func() {
+			ca := &CustomArguments{stub: stub}
+			ca.CallThrough()
+
+			ca.Return(42, nil)
+
+			Expect(ca.callThrough).To(BeFalse())
+		})
+
+		It("reports an error if the original function is nil", func() {
+			stub.originalFunc = nil
+			stub.testReporter = failTestReporter
+			ca := &CustomArguments{stub: stub}
+
+			ca.CallThrough()
+
+			Expect(ca.callThrough).To(BeFalse())
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: could not call through to the original function, the function is nil",
+			}))
+		})
+	})
+
 	Describe("OnCall", func() {
 		var ca *CustomArguments
 
@@ -333,6 +575,16 @@ var _ = Describe("CustomArguments", func() {
 			Expect(ca.isMatch([]interface{}{"hi", 11})).To(BeFalse())
 		})
 
+		It("reports a panic from inside the predicate of a matcher", func() {
+			stub.testReporter = failTestReporter
+			ca := newCustomArguments(stub, []interface{}{match.Typed(func(string) bool { panic("ope") }), 1})
+
+			Expect(ca.isMatch([]interface{}{"hi", 1})).To(BeFalse())
+			Expect(failTestReporter.messages).To(Equal([]string{
+				`mocka: predicate func(string) bool panicked with ope for "hi"`,
+			}))
+		})
+
 		It("returns false if any matcher returns false", func() {
 			ca := newCustomArguments(stub, []interface{}{"hi", match.IntGreaterThan(10)})
 
@@ -1,7 +1,9 @@
 package examples
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"reflect"
 
 	"github.com/MonsantoCo/mocka/v2"
@@ -414,6 +416,22 @@ func ExampleStringPrefix() {
 	// 20
 }
 
+func ExampleStringMatching() {
+	var fn = func(s string) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.StringMatching(`^/api/v\d+/users$`)).Return(20)
+
+	fmt.Println(fn("/api/v2/users"))
+	fmt.Println(fn("/api/users"))
+	// Output: 20
+	// 10
+}
+
 func ExampleStringSuffix() {
 	var fn = func(s string) int {
 		return 0
@@ -477,3 +495,137 @@ func (mockMatcher) SupportedKinds() map[reflect.Kind]struct{} {
 func (mockMatcher) Match(interface{}) bool {
 	return false
 }
+
+func ExampleAllOf() {
+	var fn = func(path string) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.AllOf(match.StringPrefix("/api"), match.Not(match.StringContaining("admin")))).Return(20)
+
+	fmt.Println(fn("/api/users"))
+	fmt.Println(fn("/api/admin"))
+	// Output: 20
+	// 10
+}
+
+func ExampleAnyOf() {
+	var fn = func(names []string) int {
+		return len(names)
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.AnyOf(match.Nil(), match.Empty())).Return(20)
+
+	fmt.Println(fn(nil))
+	fmt.Println(fn([]string{}))
+	fmt.Println(fn([]string{"mocka"}))
+	// Output: 20
+	// 20
+	// 10
+}
+
+func ExampleNot() {
+	var fn = func(s string) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.Not(match.StringContaining("cream"))).Return(20)
+
+	fmt.Println(fn("apples"))
+	fmt.Println(fn("screams"))
+	// Output: 20
+	// 10
+}
+
+func ExampleFields() {
+	type Request struct {
+		ID     string
+		Method string
+		Path   string
+	}
+
+	var fn = func(r Request) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.Fields(map[string]interface{}{
+		"Method": "GET",
+		"Path":   match.StringPrefix("/api"),
+	})).Return(20)
+
+	fmt.Println(fn(Request{ID: "a1", Method: "GET", Path: "/api/users"}))
+	fmt.Println(fn(Request{ID: "b2", Method: "POST", Path: "/api/users"}))
+	// Output: 20
+	// 10
+}
+
+func ExampleStructWith() {
+	type Address struct {
+		Zip string
+	}
+
+	type User struct {
+		Name    string
+		Address *Address
+	}
+
+	var fn = func(u *User) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.StructWith("Address.Zip", "12345")).Return(20)
+
+	fmt.Println(fn(&User{Name: "Jane", Address: &Address{Zip: "12345"}}))
+	fmt.Println(fn(&User{Name: "John", Address: &Address{Zip: "54321"}}))
+	fmt.Println(fn(&User{Name: "Jack"}))
+	// Output: 20
+	// 10
+	// 10
+}
+
+func ExampleFunc() {
+	var fn = func(n int) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.Func(func(n int) bool { return n%2 == 0 })).Return(20)
+
+	fmt.Println(fn(2))
+	fmt.Println(fn(3))
+	// Output: 20
+	// 10
+}
+
+func ExampleTyped() {
+	var fn = func(err error) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.Typed(func(err error) bool { return errors.Is(err, io.EOF) })).Return(20)
+
+	fmt.Println(fn(fmt.Errorf("reading: %w", io.EOF)))
+	fmt.Println(fn(errors.New("ope")))
+	// Output: 20
+	// 10
+}
@@ -3,6 +3,9 @@ package examples
 import (
 	"errors"
 	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/MonsantoCo/mocka/v2"
 	"github.com/MonsantoCo/mocka/v2/match"
@@ -20,6 +23,73 @@ func ExampleFunction() {
 	// Output: 20
 }
 
+func ExampleStub_ReturnError() {
+	var fn = func(str string) ([]string, int, error) {
+		return []string{str}, len(str), nil
+	}
+
+	stub := mocka.Function(t, &fn, []string{"123"}, 3, nil)
+	defer stub.Restore()
+
+	stub.WithArgs("bad").ReturnError(errors.New("Ope"))
+
+	fmt.Println(fn("123"))
+	fmt.Println(fn("bad"))
+	// Output: [123] 3 <nil>
+	// [] 0 Ope
+}
+
+func ExampleStub_ReturnZero() {
+	var fn = func(str string) (int, error) {
+		return len(str), nil
+	}
+
+	stub := mocka.Function(t, &fn, 20, errors.New("Ope"))
+	defer stub.Restore()
+
+	stub.ReturnZero()
+
+	fmt.Println(fn("123"))
+	// Output: 0 <nil>
+}
+
+func ExampleStub_ReturnSmartDefaults() {
+	var fn = func(str string) (map[string]int, []string, error) {
+		return map[string]int{str: len(str)}, []string{str}, nil
+	}
+
+	stub := mocka.Function(t, &fn)
+	defer stub.Restore()
+
+	counts, names, err := fn("123")
+	fmt.Println(counts == nil, names == nil, err)
+
+	stub.ReturnSmartDefaults()
+
+	counts, names, err = fn("123")
+	fmt.Println(counts == nil, names == nil, err)
+	// Output: true true <nil>
+	// false false <nil>
+}
+
+func ExampleSpy() {
+	var fn = func(str string) int {
+		return len(str)
+	}
+
+	stub := mocka.Spy(t, &fn)
+	defer stub.Restore()
+
+	stub.WithArgs("override").Return(20)
+
+	fmt.Println(fn("hello"))
+	fmt.Println(fn("override"))
+	fmt.Println(stub.GetCall(0).ReturnValues())
+	// Output: 5
+	// 20
+	// [5]
+}
+
 func ExampleCreateSandbox() {
 	var fn = func(str string) int {
 		return len(str)
@@ -34,6 +104,151 @@ func ExampleCreateSandbox() {
 	// Output: 20
 }
 
+func ExampleCall_Caller() {
+	var fn = func(str string) int {
+		return len(str)
+	}
+
+	stub := mocka.Function(t, &fn, 20)
+	defer stub.Restore()
+
+	fn("123")
+
+	fmt.Println(strings.HasPrefix(filepath.Base(stub.GetFirstCall().Caller()), "mocka_test.go:"))
+	// Output: true
+}
+
+func ExampleStub_CaptureStack() {
+	var fn = func(str string) int {
+		return len(str)
+	}
+
+	stub := mocka.Function(t, &fn, 20)
+	defer stub.Restore()
+
+	stub.CaptureStack()
+	fn("123")
+
+	fmt.Println(strings.Contains(stub.GetFirstCall().Stack(), "ExampleStub_CaptureStack"))
+	// Output: true
+}
+
+func ExampleStub_WaitForCalls() {
+	var fn = func(str string) int {
+		return len(str)
+	}
+
+	stub := mocka.Function(t, &fn, 20)
+	defer stub.Restore()
+
+	go fn("background")
+
+	fmt.Println(stub.WaitForCalls(1, time.Second))
+	fmt.Println(stub.GetFirstCall().Arguments())
+	// Output: true
+	// [background]
+}
+
+func ExampleStub_SetArg() {
+	var scan = func(dest ...interface{}) error {
+		return nil
+	}
+
+	stub := mocka.Function(t, &scan, nil)
+	defer stub.Restore()
+
+	stub.SetArg(0, 42)
+	stub.SetArg(1, "apple")
+
+	var id int
+	var name string
+	_ = scan(&id, &name)
+
+	fmt.Println(id, name)
+	// Output: 42 apple
+}
+
+func ExampleStub_CallsArg() {
+	var walk = func(root string, walkFn func(path string) error) error {
+		return nil
+	}
+
+	stub := mocka.Function(t, &walk, nil)
+	defer stub.Restore()
+
+	stub.CallsArg(1, "/tmp/a.txt")
+	stub.CallsArg(1, "/tmp/b.txt")
+
+	_ = walk("/tmp", func(path string) error {
+		fmt.Println(path)
+		return nil
+	})
+
+	fmt.Println(stub.GetFirstCall().CallbackResults())
+	// Output: /tmp/a.txt
+	// /tmp/b.txt
+	// [[<nil>] [<nil>]]
+}
+
+func ExampleStub_Delay() {
+	var fn = func(str string) int {
+		return len(str)
+	}
+
+	stub := mocka.Function(t, &fn, 20)
+	defer stub.Restore()
+
+	stub.UseClock(&virtualClock{})
+	stub.Delay(time.Minute)
+
+	fn("slow")
+
+	fmt.Println(stub.GetFirstCall().Delay())
+	// Output: 1m0s
+}
+
+func ExampleInOrder() {
+	var begin = func() error { return nil }
+	var exec = func(query string) error { return nil }
+
+	beginStub := mocka.Function(t, &begin, nil)
+	defer beginStub.Restore()
+	execStub := mocka.Function(t, &exec, nil)
+	defer execStub.Restore()
+
+	_ = begin()
+	_ = exec("SELECT 1")
+
+	fmt.Println(mocka.InOrder(t, beginStub.GetFirstCall(), execStub.GetFirstCall()))
+	// Output: true
+}
+
+func ExampleSandbox_CallsInOrder() {
+	var fn1 = func(str string) int {
+		return len(str)
+	}
+	var fn2 = func(num int) int {
+		return num
+	}
+
+	sandbox := mocka.CreateSandbox(t)
+	defer sandbox.Restore()
+
+	sandbox.Function(&fn1, 20)
+	sandbox.Function(&fn2, 10)
+
+	fn1("first")
+	fn2(2)
+	fn1("third")
+
+	for _, call := range sandbox.CallsInOrder() {
+		fmt.Println(call.Arguments())
+	}
+	// Output: [first]
+	// [2]
+	// [third]
+}
+
 func ExampleCall_Arguments() {
 	var fn = func(str string) int {
 		return len(str)
@@ -509,3 +724,174 @@ func ExampleStub_WithArgs_variadic_of_interfaces() {
 	// Output: 5
 	// 20
 }
+
+func ExampleFunction2x2() {
+	var fn = func(str string, n int) (int, error) {
+		return len(str) + n, nil
+	}
+
+	stub := mocka.Function2x2(t, &fn)
+	defer stub.Restore()
+
+	stub.Return(20, nil)
+	stub.WithArgs("123", 2).Return(5, nil)
+
+	fmt.Println(fn("hello", 1))
+	fmt.Println(fn("123", 2))
+	fmt.Println(stub.GetSecondCall().Arg0())
+	// Output: 20 <nil>
+	// 5 <nil>
+	// 123
+}
+
+func ExampleStub_CallThrough() {
+	var fn = func(str string) int {
+		return len(str)
+	}
+
+	stub := mocka.Function(t, &fn, 20)
+	defer stub.Restore()
+
+	stub.CallThrough()
+	stub.WithArgs("123").Return(5)
+
+	fmt.Println(fn("123"))
+	fmt.Println(fn("hi"))
+	fmt.Println(stub.GetSecondCall().ReturnValues())
+	// Output: 5
+	// 2
+	// [2]
+}
+
+func ExampleStub_ReturnFunc() {
+	var fn = func(str string) int {
+		return len(str)
+	}
+
+	stub := mocka.Function(t, &fn, 20)
+	defer stub.Restore()
+
+	stub.ReturnFunc(func(args []interface{}) []interface{} {
+		return []interface{}{len(args[0].(string)) * 2}
+	})
+
+	fmt.Println(fn("123"))
+	// Output: 6
+}
+
+func ExampleStub_Reset() {
+	var fn = func(str string) int {
+		return len(str)
+	}
+
+	stub := mocka.Function(t, &fn, 20)
+	defer stub.Restore()
+
+	stub.Return(10)
+	fmt.Println(fn("1"), stub.CallCount())
+
+	stub.Reset()
+	fmt.Println(stub.CallCount(), fn("1"))
+	// Output: 10 1
+	// 0 20
+}
+
+func ExampleStub_ReturnsInOrder() {
+	var fn = func(str string) int {
+		return len(str)
+	}
+
+	stub := mocka.Function(t, &fn, 20)
+	defer stub.Restore()
+
+	stub.ReturnsInOrder([]interface{}{1}, []interface{}{2}).Cycle()
+
+	fmt.Println(fn("a"), fn("b"), fn("c"), fn("d"))
+	// Output: 1 2 1 2
+}
+
+func ExampleStub_Panics() {
+	var fn = func(str string) int {
+		return len(str)
+	}
+
+	stub := mocka.Function(t, &fn, 20)
+	defer stub.Restore()
+
+	stub.WithArgs("boom").Panics("Ope")
+
+	func() {
+		defer func() {
+			fmt.Println(recover())
+		}()
+		fn("boom")
+	}()
+
+	fmt.Println(stub.GetFirstCall().Panicked())
+	fmt.Println(stub.GetFirstCall().PanicValue())
+	// Output: Ope
+	// true
+	// Ope
+}
+
+func ExampleStub_Strict() {
+	var fn = func(str string) int {
+		return len(str)
+	}
+
+	stub := mocka.Function(&printTestReporter{}, &fn, 20)
+	defer stub.Restore()
+
+	stub.Strict()
+	stub.WithArgs("apple").Return(10)
+
+	fmt.Println(fn("apple"))
+	fmt.Println(fn("banana"))
+	// Output: 10
+	// mocka: unexpected call with arguments ("banana"), expected one of:
+	// 	("apple"): argument 0: expected "apple" but got "banana"
+	// 0
+}
+
+func ExampleStub_CalledWith() {
+	var fn = func(str string, num int) int {
+		return len(str) + num
+	}
+
+	stub := mocka.Spy(&printTestReporter{}, &fn)
+	defer stub.Restore()
+
+	fn("hello", 1)
+	fn("hello", 2)
+
+	fmt.Println(stub.CalledWith("hello", match.IntGreaterThan(1)))
+	fmt.Println(stub.AlwaysCalledWith("hello", 1))
+	fmt.Println(stub.CalledWithAt(1, "hello", 2))
+	fmt.Println(stub.AssertNeverCalledWith("hello", 2))
+	// Output: true
+	// false
+	// true
+	// mocka: expected func(string, int) (int) to never be called with ("hello", 2), but it was called with:
+	// 	("hello", 1)
+	// 	("hello", 2)
+	// false
+}
+
+func ExampleStub_Expect() {
+	var fn = func(str string) int {
+		return len(str)
+	}
+
+	stub := mocka.Function(t, &fn, 20)
+	defer stub.Restore()
+
+	stub.Expect().Times(2)
+	stub.WithArgs("123").Expect().Once()
+	stub.WithArgs("456").Expect().Never()
+
+	fn("123")
+	fn("789")
+
+	fmt.Println(stub.Verify())
+	// Output: true
+}
@@ -1,7 +1,9 @@
 package examples
 
 import (
+	"fmt"
 	"log"
+	"time"
 )
 
 // t is used in place of *t.Testing for examples
@@ -16,3 +18,29 @@ type mockTestReporter struct {
 func (*mockTestReporter) Errorf(f string, args ...interface{}) {
 	log.Fatalf(f, args...)
 }
+
+// printTestReporter used to print expected test
+// failures as part of an example's output
+type printTestReporter struct {
+}
+
+// Errorf prints the failure message
+func (*printTestReporter) Errorf(f string, args ...interface{}) {
+	fmt.Printf(f+"\n", args...)
+}
+
+// virtualClock used to delay stubs
+// without waiting in real time
+type virtualClock struct {
+	now time.Time
+}
+
+// Now returns the current time of the clock
+func (c *virtualClock) Now() time.Time {
+	return c.now
+}
+
+// Sleep advances the clock by the duration
+func (c *virtualClock) Sleep(d time.Duration) {
+	c.now = c.now.Add(d)
+}
@@ -0,0 +1,149 @@
+package mocka
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MonsantoCo/mocka/v2/match"
+)
+
+// unbounded is used as the maximum number of calls when
+// an expectation has no upper limit
+const unbounded = -1
+
+// Expectation describes how many times a stub, or a specific set of
+// custom arguments, is expected to be called. Expectations are checked
+// when Verify is called on the stub or the sandbox it was created from.
+type Expectation struct {
+	stub       *Stub
+	customArgs *CustomArguments
+	min        int
+	max        int
+	verified   bool
+}
+
+// newExpectation creates an expectation that the stub, or the
+// custom arguments if provided, is called at least once
+func newExpectation(stub *Stub, customArgs *CustomArguments) *Expectation {
+	return &Expectation{stub: stub, customArgs: customArgs, min: 1, max: unbounded}
+}
+
+// Times sets the expectation to be called exactly the provided number of times
+func (e *Expectation) Times(count int) {
+	e.Between(count, count)
+}
+
+// Once sets the expectation to be called exactly once
+func (e *Expectation) Once() {
+	e.Times(1)
+}
+
+// Twice sets the expectation to be called exactly twice
+func (e *Expectation) Twice() {
+	e.Times(2)
+}
+
+// Never sets the expectation to never be called
+func (e *Expectation) Never() {
+	e.Times(0)
+}
+
+// AtLeast sets the expectation to be called at least the provided number of times
+func (e *Expectation) AtLeast(count int) {
+	e.Between(count, unbounded)
+}
+
+// AtMost sets the expectation to be called at most the provided number of times
+func (e *Expectation) AtMost(count int) {
+	e.Between(0, count)
+}
+
+// Between sets the expectation to be called at least
+// the least and at most the most number of times
+func (e *Expectation) Between(least int, most int) {
+	e.stub.lock.Lock()
+	defer e.stub.lock.Unlock()
+
+	e.min = least
+	e.max = most
+}
+
+// callCount returns the number of calls counted towards the expectation
+func (e *Expectation) callCount() int {
+	if e.customArgs != nil {
+		return e.customArgs.callCount
+	}
+
+	return len(e.stub.calls)
+}
+
+// isMet returns true if the number of calls satisfies the expectation
+func (e *Expectation) isMet() bool {
+	count := e.callCount()
+	return count >= e.min && (e.max == unbounded || count <= e.max)
+}
+
+// isPending returns true if the expectation has not been verified
+func (e *Expectation) isPending() bool {
+	return !e.verified
+}
+
+// String returns a human readable description of the unmet expectation
+func (e *Expectation) String() string {
+	subject := strings.TrimSuffix(toFriendlyName(e.stub.toType()), " {}")
+	if e.customArgs != nil {
+		subject = fmt.Sprintf("%v with arguments (%v)", subject, strings.Join(mapToDescriptions(e.customArgs.arguments), ", "))
+	}
+
+	return fmt.Sprintf("expected %v to be called %v, but it was called %v", subject, describeRange(e.min, e.max), pluralizeTimes(e.callCount()))
+}
+
+// describeRange returns a human readable description of the expected number of calls
+func describeRange(least int, most int) string {
+	switch {
+	case least == most:
+		return "exactly " + pluralizeTimes(least)
+	case most == unbounded:
+		return "at least " + pluralizeTimes(least)
+	case least == 0:
+		return "at most " + pluralizeTimes(most)
+	default:
+		return fmt.Sprintf("between %v and %v times", least, most)
+	}
+}
+
+// pluralizeTimes returns the count followed by time or times
+func pluralizeTimes(count int) string {
+	if count == 1 {
+		return "1 time"
+	}
+
+	return fmt.Sprintf("%v times", count)
+}
+
+// mapToDescriptions maps a slice of interface values to their descriptions.
+// Matchers are described by what they match.
+func mapToDescriptions(values []interface{}) []string {
+	descriptions := make([]string, len(values))
+	for i, value := range values {
+		if matcher, ok := value.(match.SupportedKindsMatcher); ok {
+			descriptions[i] = match.DescriptionOf(matcher)
+			continue
+		}
+
+		descriptions[i] = fmt.Sprintf("%#v", value)
+	}
+
+	return descriptions
+}
+
+// reportUnmetExpectations reports all unmet expectations in a single
+// failure. It returns true if there were no unmet expectations.
+func reportUnmetExpectations(testReporter TestReporter, unmet []string) bool {
+	if len(unmet) == 0 {
+		return true
+	}
+
+	testReporter.Errorf("mocka: %v unmet expectation(s):\n\t%v", len(unmet), strings.Join(unmet, "\n\t"))
+	return false
+}
@@ -0,0 +1,154 @@
+package mocka
+
+import (
+	"github.com/MonsantoCo/mocka/v2/match"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("expectation", func() {
+	var (
+		fn   func(string, int) (int, error)
+		stub *Stub
+	)
+
+	BeforeEach(func() {
+		fn = func(str string, num int) (int, error) {
+			return len(str) + num, nil
+		}
+		stub = &Stub{
+			testReporter:  GinkgoT(),
+			functionPtr:   &fn,
+			outParameters: []interface{}{42, nil},
+			execFunc:      func([]interface{}) {},
+		}
+	})
+
+	Describe("newExpectation", func() {
+		It("defaults to being called at least once", func() {
+			e := newExpectation(stub, nil)
+
+			Expect(e.min).To(Equal(1))
+			Expect(e.max).To(Equal(unbounded))
+		})
+	})
+
+	DescribeTable("sets the range of expected calls",
+		func(set func(*Expectation), min int, max int) {
+			e := newExpectation(stub, nil)
+
+			set(e)
+
+			Expect(e.min).To(Equal(min))
+			Expect(e.max).To(Equal(max))
+		},
+		Entry("Times", func(e *Expectation) { e.Times(4) }, 4, 4),
+		Entry("Once", func(e *Expectation) { e.Once() }, 1, 1),
+		Entry("Twice", func(e *Expectation) { e.Twice() }, 2, 2),
+		Entry("Never", func(e *Expectation) { e.Never() }, 0, 0),
+		Entry("AtLeast", func(e *Expectation) { e.AtLeast(3) }, 3, unbounded),
+		Entry("AtMost", func(e *Expectation) { e.AtMost(3) }, 0, 3),
+		Entry("Between", func(e *Expectation) { e.Between(2, 5) }, 2, 5),
+	)
+
+	Describe("isMet", func() {
+		It("counts the calls to the stub", func() {
+			e := newExpectation(stub, nil)
+			e.Twice()
+
+			Expect(e.isMet()).To(BeFalse())
+
+			stub.calls = []Call{{}, {}}
+
+			Expect(e.isMet()).To(BeTrue())
+
+			stub.calls = append(stub.calls, Call{})
+
+			Expect(e.isMet()).To(BeFalse())
+		})
+
+		It("counts the calls to the custom arguments", func() {
+			ca := &CustomArguments{stub: stub, callCount: 1}
+			e := newExpectation(stub, ca)
+			stub.calls = []Call{{}, {}}
+
+			e.Once()
+
+			Expect(e.isMet()).To(BeTrue())
+		})
+
+		It("has no upper limit when unbounded", func() {
+			e := newExpectation(stub, nil)
+			stub.calls = make([]Call, 100)
+
+			Expect(e.isMet()).To(BeTrue())
+		})
+	})
+
+	Describe("isPending", func() {
+		It("returns true until the expectation has been verified", func() {
+			e := newExpectation(stub, nil)
+
+			Expect(e.isPending()).To(BeTrue())
+
+			e.verified = true
+
+			Expect(e.isPending()).To(BeFalse())
+		})
+	})
+
+	Describe("String", func() {
+		It("describes the unmet expectation for the stub", func() {
+			e := newExpectation(stub, nil)
+			e.Times(2)
+			stub.calls = []Call{{}}
+
+			Expect(e.String()).To(Equal("expected func(string, int) (int, error) to be called exactly 2 times, but it was called 1 time"))
+		})
+
+		It("describes the unmet expectation for the custom arguments", func() {
+			ca := &CustomArguments{stub: stub, arguments: []interface{}{"apple", 0}}
+			e := newExpectation(stub, ca)
+
+			Expect(e.String()).To(Equal(`expected func(string, int) (int, error) with arguments ("apple", 0) to be called at least 1 time, but it was called 0 times`))
+		})
+	})
+
+	Describe("mapToDescriptions", func() {
+		It("describes values with their Go syntax and matchers with what they match", func() {
+			Expect(mapToDescriptions([]interface{}{"apple", match.StringPrefix("/api"), &panicMatcher{}})).To(Equal([]string{
+				`"apple"`,
+				`string with prefix "/api"`,
+				"value matching *mocka.panicMatcher",
+			}))
+		})
+	})
+
+	DescribeTable("describeRange",
+		func(min int, max int, expected string) {
+			Expect(describeRange(min, max)).To(Equal(expected))
+		},
+		Entry("never", 0, 0, "exactly 0 times"),
+		Entry("exactly", 3, 3, "exactly 3 times"),
+		Entry("at least", 1, unbounded, "at least 1 time"),
+		Entry("at most", 0, 2, "at most 2 times"),
+		Entry("between", 1, 2, "between 1 and 2 times"),
+	)
+
+	Describe("reportUnmetExpectations", func() {
+		It("returns true and reports nothing when all expectations are met", func() {
+			reporter := &mockTestReporter{}
+
+			Expect(reportUnmetExpectations(reporter, nil)).To(BeTrue())
+			Expect(reporter.messages).To(BeEmpty())
+		})
+
+		It("reports all unmet expectations in a single failure", func() {
+			reporter := &mockTestReporter{}
+
+			Expect(reportUnmetExpectations(reporter, []string{"first", "second"})).To(BeFalse())
+			Expect(reporter.messages).To(Equal([]string{"mocka: 2 unmet expectation(s):\n\tfirst\n\tsecond"}))
+		})
+	})
+})
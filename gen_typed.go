@@ -0,0 +1,461 @@
+//go:build ignore
+
+// gen_typed generates typedStubs.go, the type-safe stubs for every supported
+// combination of arguments and return values. Run it with go generate.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+)
+
+// The type-safe stubs cover the signatures most functions worth stubbing
+// have. Every combination adds exported identifiers, so functions with more
+// arguments or return values are left to the reflection based Function.
+const (
+	// maxArguments is the largest number of arguments a type-safe stub supports
+	maxArguments = 5
+
+	// maxResults is the largest number of return values a type-safe stub supports
+	maxResults = 3
+)
+
+// numbers spells out the counts used in doc comments
+var numbers = []string{"no", "one", "two", "three", "four", "five"}
+
+// results describes the return values of the stubbed functions
+type results struct {
+	Count int
+}
+
+// Names returns the type parameters of the return values, such as R0, R1
+func (r results) Names() []string {
+	return names("R", r.Count)
+}
+
+// Suffix returns the type parameters of the return values to append to
+// another list of type parameters, such as ", R0, R1"
+func (r results) Suffix() string {
+	if r.Count == 0 {
+		return ""
+	}
+
+	return ", " + strings.Join(r.Names(), ", ")
+}
+
+// Params returns the return values as parameters, such as "r0 R0, r1 R1"
+func (r results) Params() string {
+	params := make([]string, r.Count)
+	for i, name := range r.Names() {
+		params[i] = fmt.Sprintf("r%v %v", i, name)
+	}
+
+	return strings.Join(params, ", ")
+}
+
+// Values returns the parameters of the return values, such as "r0, r1"
+func (r results) Values() string {
+	return strings.Join(names("r", r.Count), ", ")
+}
+
+// Signature returns the return values of a function type, such as " (R0, R1)"
+func (r results) Signature() string {
+	switch r.Count {
+	case 0:
+		return ""
+	case 1:
+		return " R0"
+	default:
+		return " (" + strings.Join(r.Names(), ", ") + ")"
+	}
+}
+
+// Description returns the number of return values in words
+func (r results) Description() string {
+	switch r.Count {
+	case 0:
+		return "no return values"
+	case 1:
+		return "one return value"
+	default:
+		return numbers[r.Count] + " return values"
+	}
+}
+
+// function describes a stubbed function
+type function struct {
+	Arguments int
+	Variadic  bool
+	Results   results
+}
+
+// Name returns the suffix of the names of the stub, its constructors and
+// its calls, such as 2x1 or 2Vx1 for a variadic function
+func (f function) Name() string {
+	if f.Variadic {
+		return fmt.Sprintf("%vVx%v", f.Arguments, f.Results.Count)
+	}
+
+	return fmt.Sprintf("%vx%v", f.Arguments, f.Results.Count)
+}
+
+// TypeParams returns the declaration of the type parameters, such as "[A0, R0 any]"
+func (f function) TypeParams() string {
+	params := append(names("A", f.Arguments), f.Results.Names()...)
+	if len(params) == 0 {
+		return ""
+	}
+
+	return "[" + strings.Join(params, ", ") + " any]"
+}
+
+// ConstructorTypeParams returns the declaration of the type parameters of the
+// constructor, which takes a pointer to any function type with the signature,
+// such as "[F ~func(A0) R0, A0, R0 any]"
+func (f function) ConstructorTypeParams() string {
+	params := append(names("A", f.Arguments), f.Results.Names()...)
+	if len(params) == 0 {
+		return "[F ~" + f.Signature() + "]"
+	}
+
+	return "[F ~" + f.Signature() + ", " + strings.Join(params, ", ") + " any]"
+}
+
+// TypeArgs returns the type arguments, such as "[A0, R0]"
+func (f function) TypeArgs() string {
+	args := append(names("A", f.Arguments), f.Results.Names()...)
+	if len(args) == 0 {
+		return ""
+	}
+
+	return "[" + strings.Join(args, ", ") + "]"
+}
+
+// Args returns the type-safe arguments, such as the last element of
+// "A0, ...A1" for a variadic function
+func (f function) Args() []arg {
+	args := make([]arg, f.Arguments)
+	for i := range args {
+		args[i] = arg{Index: i, Variadic: f.Variadic && i == f.Arguments-1}
+	}
+
+	return args
+}
+
+// Signature returns the type of the stubbed function, such as "func(A0, ...A1) R0"
+func (f function) Signature() string {
+	types := make([]string, f.Arguments)
+	for i, a := range f.Args() {
+		types[i] = a.Type()
+	}
+
+	return "func(" + strings.Join(types, ", ") + ")" + f.Results.Signature()
+}
+
+// Params returns the arguments as parameters, such as "a0 A0, a1 ...A1"
+func (f function) Params() string {
+	params := make([]string, f.Arguments)
+	for i, a := range f.Args() {
+		params[i] = fmt.Sprintf("a%v %v", i, a.Type())
+	}
+
+	return strings.Join(params, ", ")
+}
+
+// Values returns the arguments as values for the reflection based stub
+func (f function) Values() string {
+	values := names("a", f.Arguments)
+	if !f.Variadic {
+		return strings.Join(values, ", ")
+	}
+
+	last := len(values) - 1
+	return fmt.Sprintf("appendVariadic([]interface{}{%v}, %v)...", strings.Join(values[:last], ", "), values[last])
+}
+
+// Description returns the number of arguments and return values in words
+func (f function) Description() string {
+	arguments := numbers[f.Arguments] + " arguments"
+	if f.Arguments == 1 {
+		arguments = "one argument"
+	}
+
+	if f.Variadic && f.Arguments == 1 {
+		arguments = "one variadic argument"
+	} else if f.Variadic {
+		arguments += ", the last of which is variadic,"
+	}
+
+	return arguments + " and " + f.Results.Description()
+}
+
+// arg describes an argument of a stubbed function
+type arg struct {
+	Index    int
+	Variadic bool
+}
+
+// Type returns the type of the argument as declared, such as "...A1"
+func (a arg) Type() string {
+	if a.Variadic {
+		return fmt.Sprintf("...A%v", a.Index)
+	}
+
+	return fmt.Sprintf("A%v", a.Index)
+}
+
+// ValueType returns the type of the value the function receives, such as "[]A1"
+func (a arg) ValueType() string {
+	if a.Variadic {
+		return fmt.Sprintf("[]A%v", a.Index)
+	}
+
+	return fmt.Sprintf("A%v", a.Index)
+}
+
+// names returns the numbered names with the prefix, such as A0, A1
+func names(prefix string, count int) []string {
+	names := make([]string, count)
+	for i := range names {
+		names[i] = fmt.Sprintf("%v%v", prefix, i)
+	}
+
+	return names
+}
+
+var typedStubs = template.Must(template.New("typedStubs").Parse(`// Code generated by gen_typed.go; DO NOT EDIT.
+
+package mocka
+
+import "github.com/MonsantoCo/mocka/v2/match"
+{{range .Results}}{{$r := .}}
+// returns{{.Count}} provides the type-safe return value methods for
+// stubs of functions with {{.Description}}
+type returns{{.Count}}[F any{{if .Count}}{{.Suffix}} any{{end}}] struct {
+	*Stub
+}
+
+// Return updates the default out parameters returned when
+// the mock function is called
+func (r returns{{.Count}}[F{{.Suffix}}]) Return({{.Params}}) {
+	r.Stub.Return({{.Values}})
+}
+
+// ReturnFunc assigns a function with the same signature as the original
+// function that computes the return values from the arguments of each call
+func (r returns{{.Count}}[F{{.Suffix}}]) ReturnFunc(returnFunc F) {
+	r.Stub.ReturnFunc(typedReturnFunc(returnFunc))
+}
+
+// WithMatchers returns type-safe custom arguments that can change the out
+// parameters returned based on the matchers provided to this function
+func (r returns{{.Count}}[F{{.Suffix}}]) WithMatchers(matchers ...match.SupportedKindsMatcher) *CustomArguments{{.Count}}[F{{.Suffix}}] {
+	return &CustomArguments{{.Count}}[F{{.Suffix}}]{r.Stub.WithArgs(mapMatchersToInterfaces(matchers)...)}
+}
+
+// OnCall returns a type-safe OnCall that allows for changing the
+// return values based on the call index.
+func (r returns{{.Count}}[F{{.Suffix}}]) OnCall(index int) *OnCall{{.Count}}[F{{.Suffix}}] {
+	return &OnCall{{.Count}}[F{{.Suffix}}]{r.Stub.OnCall(index)}
+}
+
+// OnFirstCall returns a type-safe OnCall that allows for changing the
+// return values of the first call.
+func (r returns{{.Count}}[F{{.Suffix}}]) OnFirstCall() *OnCall{{.Count}}[F{{.Suffix}}] {
+	return r.OnCall(0)
+}
+
+// OnSecondCall returns a type-safe OnCall that allows for changing the
+// return values of the second call.
+func (r returns{{.Count}}[F{{.Suffix}}]) OnSecondCall() *OnCall{{.Count}}[F{{.Suffix}}] {
+	return r.OnCall(1)
+}
+
+// OnThirdCall returns a type-safe OnCall that allows for changing the
+// return values of the third call.
+func (r returns{{.Count}}[F{{.Suffix}}]) OnThirdCall() *OnCall{{.Count}}[F{{.Suffix}}] {
+	return r.OnCall(2)
+}
+
+// CustomArguments{{.Count}} is the type-safe representation of custom
+// arguments for functions of type F with {{.Description}}
+type CustomArguments{{.Count}}[F any{{if .Count}}{{.Suffix}} any{{end}}] struct {
+	*CustomArguments
+}
+
+// Return sets the return values for this set of custom arguments
+func (ca *CustomArguments{{.Count}}[F{{.Suffix}}]) Return({{.Params}}) {
+	ca.CustomArguments.Return({{.Values}})
+}
+
+// ReturnFunc assigns a function with the same signature as the original
+// function that computes the return values for this set of custom arguments
+// from the arguments of each call
+func (ca *CustomArguments{{.Count}}[F{{.Suffix}}]) ReturnFunc(returnFunc F) {
+	ca.CustomArguments.ReturnFunc(typedReturnFunc(returnFunc))
+}
+
+// OnCall returns a type-safe OnCall that allows for changing the
+// return values based on the call index for this specific set
+// of custom arguments.
+func (ca *CustomArguments{{.Count}}[F{{.Suffix}}]) OnCall(callIndex int) *OnCall{{.Count}}[F{{.Suffix}}] {
+	return &OnCall{{.Count}}[F{{.Suffix}}]{ca.CustomArguments.OnCall(callIndex)}
+}
+
+// OnFirstCall returns a type-safe OnCall that allows for changing the
+// return values of the first call for this specific set
+// of custom arguments.
+func (ca *CustomArguments{{.Count}}[F{{.Suffix}}]) OnFirstCall() *OnCall{{.Count}}[F{{.Suffix}}] {
+	return ca.OnCall(0)
+}
+
+// OnSecondCall returns a type-safe OnCall that allows for changing the
+// return values of the second call for this specific set
+// of custom arguments.
+func (ca *CustomArguments{{.Count}}[F{{.Suffix}}]) OnSecondCall() *OnCall{{.Count}}[F{{.Suffix}}] {
+	return ca.OnCall(1)
+}
+
+// OnThirdCall returns a type-safe OnCall that allows for changing the
+// return values of the third call for this specific set
+// of custom arguments.
+func (ca *CustomArguments{{.Count}}[F{{.Suffix}}]) OnThirdCall() *OnCall{{.Count}}[F{{.Suffix}}] {
+	return ca.OnCall(2)
+}
+
+// OnCall{{.Count}} is the type-safe representation of a call
+// index for functions of type F with {{.Description}}
+type OnCall{{.Count}}[F any{{if .Count}}{{.Suffix}} any{{end}}] struct {
+	*OnCall
+}
+
+// Return sets the return values for this call index
+func (c *OnCall{{.Count}}[F{{.Suffix}}]) Return({{.Params}}) {
+	c.OnCall.Return({{.Values}})
+}
+
+// ReturnFunc assigns a function with the same signature as the original
+// function that computes the return values for this call index from the
+// arguments of each call
+func (c *OnCall{{.Count}}[F{{.Suffix}}]) ReturnFunc(returnFunc F) {
+	c.OnCall.ReturnFunc(typedReturnFunc(returnFunc))
+}
+{{end}}{{range .Functions}}{{$f := .}}{{$name := .Name}}{{$typeArgs := .TypeArgs}}{{$returns := printf "returns%v[%v%v]" .Results.Count .Signature .Results.Suffix}}
+// Stub{{$name}} is a type-safe stub for functions with {{.Description}}.
+type Stub{{$name}}{{.TypeParams}} struct {
+	{{$returns}}
+}
+
+// Function{{$name}} replaces the provided function, which has
+// {{.Description}}, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function{{$name}}{{.ConstructorTypeParams}}(testReporter TestReporter, originalFuncPtr *F) *Stub{{$name}}{{$typeArgs}} {
+	return newStub{{$name}}{{$typeArgs}}(Function(testReporter, originalFuncPtr))
+}
+
+// newStub{{$name}} wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub{{$name}}{{.TypeParams}}(stub *Stub) *Stub{{$name}}{{$typeArgs}} {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub{{$name}}{{$typeArgs}}{{"{"}}{{$returns}}{stub}}
+}
+{{if .Arguments}}
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub{{$name}}{{$typeArgs}}) WithArgs({{.Params}}) *CustomArguments{{.Results.Count}}[{{.Signature}}{{.Results.Suffix}}] {
+	return &CustomArguments{{.Results.Count}}[{{.Signature}}{{.Results.Suffix}}]{s.Stub.WithArgs({{.Values}})}
+}
+{{end}}
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub{{$name}}{{$typeArgs}}) GetCalls() []Call{{$name}}{{$typeArgs}} {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call{{$name}}{{$typeArgs}} { return Call{{$name}}{{$typeArgs}}{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub{{$name}}{{$typeArgs}}) GetCall(callIndex int) Call{{$name}}{{$typeArgs}} {
+	return Call{{$name}}{{$typeArgs}}{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub{{$name}}{{$typeArgs}}) GetFirstCall() Call{{$name}}{{$typeArgs}} {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub{{$name}}{{$typeArgs}}) GetSecondCall() Call{{$name}}{{$typeArgs}} {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub{{$name}}{{$typeArgs}}) GetThirdCall() Call{{$name}}{{$typeArgs}} {
+	return s.GetCall(2)
+}
+
+// Call{{$name}} is the type-safe representation of a call to a function
+// with {{.Description}}
+type Call{{$name}}{{.TypeParams}} struct {
+	Call
+}
+{{range .Args}}
+// Arg{{.Index}} returns argument {{.Index}} the stub was called with{{if .Variadic}}, the
+// variadic arguments as a slice{{end}}
+func (c Call{{$name}}{{$typeArgs}}) Arg{{.Index}}() {{.ValueType}} {
+	return valueAt[{{.ValueType}}](c.args, {{.Index}})
+}
+{{end}}{{range $i, $r := .Results.Names}}
+// ReturnValue{{$i}} returns return value {{$i}} of the stubbed implementation
+func (c Call{{$name}}{{$typeArgs}}) ReturnValue{{$i}}() {{$r}} {
+	return valueAt[{{$r}}](c.out, {{$i}})
+}
+{{end}}{{end}}`))
+
+func main() {
+	var data struct {
+		Results   []results
+		Functions []function
+	}
+
+	for r := 0; r <= maxResults; r++ {
+		data.Results = append(data.Results, results{Count: r})
+	}
+
+	for a := 0; a <= maxArguments; a++ {
+		for _, variadic := range []bool{false, true} {
+			if variadic && a == 0 {
+				continue
+			}
+
+			for _, r := range data.Results {
+				data.Functions = append(data.Functions, function{Arguments: a, Variadic: variadic, Results: r})
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := typedStubs.Execute(&buf, data); err != nil {
+		log.Fatal(err)
+	}
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile("typedStubs.go", source, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,75 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// AllOf returns a new matcher that will match when every provided
+// matcher matches. It supports the kinds supported by every matcher.
+func AllOf(matchers ...SupportedKindsMatcher) SupportedKindsMatcher {
+	return &allOf{matchers}
+}
+
+type allOf struct {
+	matchers []SupportedKindsMatcher
+}
+
+// SupportedKinds returns the kinds supported by every matcher
+func (m *allOf) SupportedKinds() map[reflect.Kind]struct{} {
+	kinds := Anything().SupportedKinds()
+	for _, matcher := range m.matchers {
+		supported := matcher.SupportedKinds()
+		for kind := range kinds {
+			if _, ok := supported[kind]; !ok {
+				delete(kinds, kind)
+			}
+		}
+	}
+
+	return kinds
+}
+
+// SupportsType returns true if every matcher supports the type
+func (m *allOf) SupportsType(t reflect.Type) bool {
+	for _, matcher := range m.matchers {
+		if !Supports(matcher, t) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Match returns true if every matcher matches the value
+func (m *allOf) Match(value interface{}) bool {
+	for _, matcher := range m.matchers {
+		if !matches(matcher, value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Description returns a description of the values every matcher matches
+func (m *allOf) Description() string {
+	return fmt.Sprintf("all of (%v)", describeAll(m.matchers))
+}
+
+// ExplainMismatch returns why the value did not match the first matcher
+// that does not match it
+func (m *allOf) ExplainMismatch(actual interface{}) string {
+	for _, matcher := range m.matchers {
+		if !matches(matcher, actual) {
+			return ExplainMismatch(matcher, actual)
+		}
+	}
+
+	return mismatch(m.Description(), actual)
+}
+
+// Validate returns the error of the first matcher that is not valid
+func (m *allOf) Validate() error {
+	return validateMatchers(m.matchers)
+}
@@ -0,0 +1,76 @@
+package match
+
+import (
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+)
+
+var _ = Describe("allOf", func() {
+	Describe("AllOf", func() {
+		It("returns an allOf struct", func() {
+			actual := AllOf(Anything())
+
+			Expect(actual).To(BeAssignableToTypeOf(new(allOf)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns the kinds supported by every matcher", func() {
+			actual := AllOf(Empty(), Nil()).SupportedKinds()
+
+			Expect(actual).To(Equal(
+				map[reflect.Kind]struct{}{
+					reflect.Map:   {},
+					reflect.Slice: {},
+				}))
+		})
+
+		It("returns all kinds without matchers", func() {
+			Expect(AllOf().SupportedKinds()).To(Equal(Anything().SupportedKinds()))
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(matcher SupportedKindsMatcher, actual interface{}) {
+			Expect(matcher.Match(actual)).To(BeTrue())
+		},
+		Entry("when every matcher matches", AllOf(StringPrefix("/api"), Not(StringContaining("admin"))), "/api/users"),
+		Entry("when there are no matchers", AllOf(), 42),
+	)
+
+	DescribeTable("Match returns false",
+		func(matcher SupportedKindsMatcher, actual interface{}) {
+			Expect(matcher.Match(actual)).To(BeFalse())
+		},
+		Entry("when any matcher does not match", AllOf(StringPrefix("/api"), Not(StringContaining("admin"))), "/api/admin"),
+		Entry("when a matcher panics", AllOf(Anything(), Nil()), "/api"),
+	)
+
+	Describe("Description", func() {
+		It("describes every matcher", func() {
+			Expect(AllOf(StringPrefix("/api"), LengthOf(4)).(Describer).Description()).
+				To(Equal(`all of (string with prefix "/api", value with length 4)`))
+		})
+	})
+
+	Describe("ExplainMismatch", func() {
+		It("explains the mismatch of the first matcher that does not match", func() {
+			Expect(AllOf(StringPrefix("/"), StringPrefix("/api")).(Describer).ExplainMismatch("/v1/users")).
+				To(Equal(`expected string with prefix "/api" but got "/v1/users"`))
+		})
+	})
+
+	Describe("Validate", func() {
+		It("returns the error of a matcher that is not valid", func() {
+			err := AllOf(Anything(), StringMatching("[")).(Validator).Validate()
+
+			Expect(err.Error()).To(Equal("error parsing regexp: missing closing ]: `[`"))
+		})
+
+		It("returns nil if every matcher is valid", func() {
+			Expect(AllOf(Anything(), StringMatching("a")).(Validator).Validate()).To(Succeed())
+		})
+	})
+})
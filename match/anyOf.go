@@ -0,0 +1,65 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// AnyOf returns a new matcher that will match when at least one of the
+// provided matchers matches. It supports the kinds supported by any matcher.
+func AnyOf(matchers ...SupportedKindsMatcher) SupportedKindsMatcher {
+	return &anyOf{matchers}
+}
+
+type anyOf struct {
+	matchers []SupportedKindsMatcher
+}
+
+// SupportedKinds returns the kinds supported by any of the matchers
+func (m *anyOf) SupportedKinds() map[reflect.Kind]struct{} {
+	kinds := map[reflect.Kind]struct{}{}
+	for _, matcher := range m.matchers {
+		for kind := range matcher.SupportedKinds() {
+			kinds[kind] = struct{}{}
+		}
+	}
+
+	return kinds
+}
+
+// SupportsType returns true if any of the matchers supports the type
+func (m *anyOf) SupportsType(t reflect.Type) bool {
+	for _, matcher := range m.matchers {
+		if Supports(matcher, t) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Match returns true if any of the matchers matches the value
+func (m *anyOf) Match(value interface{}) bool {
+	for _, matcher := range m.matchers {
+		if matches(matcher, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Description returns a description of the values any of the matchers match
+func (m *anyOf) Description() string {
+	return fmt.Sprintf("any of (%v)", describeAll(m.matchers))
+}
+
+// ExplainMismatch returns why the value did not match any of the matchers
+func (m *anyOf) ExplainMismatch(actual interface{}) string {
+	return mismatch(m.Description(), actual)
+}
+
+// Validate returns the error of the first matcher that is not valid
+func (m *anyOf) Validate() error {
+	return validateMatchers(m.matchers)
+}
@@ -0,0 +1,80 @@
+package match
+
+import (
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+)
+
+var _ = Describe("anyOf", func() {
+	Describe("AnyOf", func() {
+		It("returns an anyOf struct", func() {
+			actual := AnyOf(Anything())
+
+			Expect(actual).To(BeAssignableToTypeOf(new(anyOf)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns the kinds supported by any matcher", func() {
+			actual := AnyOf(StringPrefix(""), IntGreaterThan(0)).SupportedKinds()
+
+			Expect(actual).To(Equal(
+				map[reflect.Kind]struct{}{
+					reflect.String: {},
+					reflect.Int:    {},
+					reflect.Int8:   {},
+					reflect.Int16:  {},
+					reflect.Int32:  {},
+					reflect.Int64:  {},
+				}))
+		})
+
+		It("returns no kinds without matchers", func() {
+			Expect(AnyOf().SupportedKinds()).To(Equal(map[reflect.Kind]struct{}{}))
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(matcher SupportedKindsMatcher, actual interface{}) {
+			Expect(matcher.Match(actual)).To(BeTrue())
+		},
+		Entry("when the first matcher matches", AnyOf(Nil(), Empty()), []int(nil)),
+		Entry("when the last matcher matches", AnyOf(Nil(), Empty()), []int{}),
+		Entry("when an earlier matcher panics", AnyOf(Nil(), Empty()), ""),
+	)
+
+	DescribeTable("Match returns false",
+		func(matcher SupportedKindsMatcher, actual interface{}) {
+			Expect(matcher.Match(actual)).To(BeFalse())
+		},
+		Entry("when no matcher matches", AnyOf(Nil(), Empty()), []int{1}),
+		Entry("when there are no matchers", AnyOf(), 42),
+	)
+
+	Describe("Description", func() {
+		It("describes every matcher", func() {
+			Expect(AnyOf(Nil(), Empty()).(Describer).Description()).To(Equal("any of (nil, empty value)"))
+		})
+	})
+
+	Describe("ExplainMismatch", func() {
+		It("explains that the value matched none of the matchers", func() {
+			Expect(AnyOf(Nil(), Empty()).(Describer).ExplainMismatch([]int{1})).
+				To(Equal("expected any of (nil, empty value) but got []int{1}"))
+		})
+	})
+
+	Describe("Validate", func() {
+		It("returns the error of a matcher that is not valid", func() {
+			err := AnyOf(Anything(), StringMatching("[")).(Validator).Validate()
+
+			Expect(err.Error()).To(Equal("error parsing regexp: missing closing ]: `[`"))
+		})
+
+		It("returns nil if every matcher is valid", func() {
+			Expect(AnyOf(Anything(), StringMatching("a")).(Validator).Validate()).To(Succeed())
+		})
+	})
+})
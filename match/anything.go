@@ -46,3 +46,13 @@ func (anything) SupportedKinds() map[reflect.Kind]struct{} {
 func (anything) Match(_ interface{}) bool {
 	return true
 }
+
+// Description returns a description of the values the anything matcher matches
+func (anything) Description() string {
+	return "anything"
+}
+
+// ExplainMismatch returns why the value did not match the anything matcher
+func (m *anything) ExplainMismatch(actual interface{}) string {
+	return mismatch(m.Description(), actual)
+}
@@ -27,3 +27,13 @@ func (anythingButNil) Match(value interface{}) bool {
 	v := reflect.ValueOf(value)
 	return v.IsValid() && !v.IsNil()
 }
+
+// Description returns a description of the values the anything but nil matcher matches
+func (anythingButNil) Description() string {
+	return "anything but nil"
+}
+
+// ExplainMismatch returns why the value did not match the anything but nil matcher
+func (m *anythingButNil) ExplainMismatch(actual interface{}) string {
+	return mismatch(m.Description(), actual)
+}
@@ -5,7 +5,6 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("anythingButNil", func() {
@@ -56,4 +55,16 @@ var _ = Describe("anythingButNil", func() {
 		Entry("with nil pointer", (*anythingButNil)(nil)),
 		Entry("with nil slice", ([]string)(nil)),
 	)
+
+	Describe("Description", func() {
+		It("describes the values the matcher matches", func() {
+			Expect(AnythingButNil().(Describer).Description()).To(Equal(`anything but nil`))
+		})
+	})
+
+	Describe("ExplainMismatch", func() {
+		It("explains that the value is not the described value", func() {
+			Expect(AnythingButNil().(Describer).ExplainMismatch(nil)).To(Equal(`expected anything but nil but got <nil>`))
+		})
+	})
 })
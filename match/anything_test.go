@@ -5,7 +5,6 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("anything", func() {
@@ -67,4 +66,10 @@ var _ = Describe("anything", func() {
 		Entry("with slice", []string{"screams"}),
 		Entry("with array", [1]int{1}),
 	)
+
+	Describe("Description", func() {
+		It("describes the values the matcher matches", func() {
+			Expect(Anything().(Describer).Description()).To(Equal(`anything`))
+		})
+	})
 })
@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -61,3 +62,13 @@ func (m *convertibleTo) Match(value interface{}) bool {
 
 	return actualType.ConvertibleTo(expectedType.Elem())
 }
+
+// Description returns a description of the values the convertible to matcher matches
+func (m *convertibleTo) Description() string {
+	return fmt.Sprintf("value convertible to %v", pointedType(m.value))
+}
+
+// ExplainMismatch returns why the value did not match the convertible to matcher
+func (m *convertibleTo) ExplainMismatch(actual interface{}) string {
+	return mismatch(m.Description(), actual)
+}
@@ -5,7 +5,6 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("convertibleTo", func() {
@@ -72,4 +71,16 @@ var _ = Describe("convertibleTo", func() {
 		Entry("when expected is not an pointer", *new(int), 10),
 		Entry("when actual cannot be converted to expected", new(int), []string{}),
 	)
+
+	Describe("Description", func() {
+		It("describes the values the matcher matches", func() {
+			Expect(ConvertibleTo((*int)(nil)).(Describer).Description()).To(Equal(`value convertible to int`))
+		})
+	})
+
+	Describe("ExplainMismatch", func() {
+		It("explains that the value is not the described value", func() {
+			Expect(ConvertibleTo((*int)(nil)).(Describer).ExplainMismatch("hello")).To(Equal(`expected value convertible to int but got "hello"`))
+		})
+	})
 })
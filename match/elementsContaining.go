@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -51,3 +52,13 @@ func (m *elementsContaining) Match(value interface{}) bool {
 		return false
 	}
 }
+
+// Description returns a description of the values the elements containing matcher matches
+func (m *elementsContaining) Description() string {
+	return fmt.Sprintf("elements containing (%v)", describeValues(m.elements))
+}
+
+// ExplainMismatch returns why the value did not match the elements containing matcher
+func (m *elementsContaining) ExplainMismatch(actual interface{}) string {
+	return mismatch(m.Description(), actual)
+}
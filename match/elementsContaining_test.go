@@ -5,7 +5,6 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("elementsContaining", func() {
@@ -57,4 +56,16 @@ var _ = Describe("elementsContaining", func() {
 			"1", "2", "4", "5",
 		}),
 	)
+
+	Describe("Description", func() {
+		It("describes the values the matcher matches", func() {
+			Expect(ElementsContaining(1, 2).(Describer).Description()).To(Equal(`elements containing (1, 2)`))
+		})
+	})
+
+	Describe("ExplainMismatch", func() {
+		It("explains that the value is not the described value", func() {
+			Expect(ElementsContaining(1, 2).(Describer).ExplainMismatch([]int{3})).To(Equal(`expected elements containing (1, 2) but got []int{3}`))
+		})
+	})
 })
@@ -38,3 +38,13 @@ func (empty) Match(value interface{}) bool {
 		return false
 	}
 }
+
+// Description returns a description of the values the empty matcher matches
+func (empty) Description() string {
+	return "empty value"
+}
+
+// ExplainMismatch returns why the value did not match the empty matcher
+func (m *empty) ExplainMismatch(actual interface{}) string {
+	return mismatch(m.Description(), actual)
+}
@@ -5,7 +5,6 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("empty", func() {
@@ -52,4 +51,16 @@ var _ = Describe("empty", func() {
 		Entry("when length != 0 for string", "hello"),
 		Entry("when length != 0 for map", map[int]string{0: "a"}),
 	)
+
+	Describe("Description", func() {
+		It("describes the values the matcher matches", func() {
+			Expect(Empty().(Describer).Description()).To(Equal(`empty value`))
+		})
+	})
+
+	Describe("ExplainMismatch", func() {
+		It("explains that the value is not the described value", func() {
+			Expect(Empty().(Describer).ExplainMismatch([]int{1})).To(Equal(`expected empty value but got []int{1}`))
+		})
+	})
 })
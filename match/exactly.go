@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -49,3 +50,13 @@ func (exactly) SupportedKinds() map[reflect.Kind]struct{} {
 func (m *exactly) Match(value interface{}) bool {
 	return reflect.DeepEqual(m.value, value)
 }
+
+// Description returns a description of the values the exactly matcher matches
+func (m *exactly) Description() string {
+	return fmt.Sprintf("%#v", m.value)
+}
+
+// ExplainMismatch returns why the value did not match the exactly matcher
+func (m *exactly) ExplainMismatch(actual interface{}) string {
+	return mismatch(m.Description(), actual)
+}
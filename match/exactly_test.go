@@ -5,7 +5,6 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("exactly", func() {
@@ -101,4 +100,16 @@ var _ = Describe("exactly", func() {
 		Entry("when arrays are not equal", [1]int{1}, [1]int{3}),
 		Entry("when maps are not equal", map[string]struct{}{"a": struct{}{}}, map[string]struct{}{"b": struct{}{}}),
 	)
+
+	Describe("Description", func() {
+		It("describes the values the matcher matches", func() {
+			Expect(Exactly("hello").(Describer).Description()).To(Equal(`"hello"`))
+		})
+	})
+
+	Describe("ExplainMismatch", func() {
+		It("explains that the value is not the described value", func() {
+			Expect(Exactly("hello").(Describer).ExplainMismatch("goodbye")).To(Equal(`expected "hello" but got "goodbye"`))
+		})
+	})
 })
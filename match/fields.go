@@ -0,0 +1,230 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Fields returns a new matcher that will match a struct, or a pointer to a
+// struct, when the provided fields match. The keys are the paths of the
+// fields, such as "User.Address.Zip", and the values are either matchers or
+// values the fields must equal. Fields that are not provided are ignored.
+func Fields(fields map[string]interface{}) SupportedKindsMatcher {
+	return &structFields{fields}
+}
+
+// StructWith returns a new matcher that will match a struct, or a pointer to
+// a struct, when the field at the path matches the provided matcher or value
+func StructWith(path string, value interface{}) SupportedKindsMatcher {
+	return &structFields{map[string]interface{}{path: value}}
+}
+
+type structFields struct {
+	fields map[string]interface{}
+}
+
+// SupportedKinds returns all the kinds the struct fields matcher supports
+func (structFields) SupportedKinds() map[reflect.Kind]struct{} {
+	return map[reflect.Kind]struct{}{
+		reflect.Interface: {},
+		reflect.Ptr:       {},
+		reflect.Struct:    {},
+	}
+}
+
+// Match returns true if every provided field is found and matches
+func (m *structFields) Match(value interface{}) bool {
+	return m.firstMismatch(value) == ""
+}
+
+// Description returns a description of the values the struct fields matcher matches
+func (m *structFields) Description() string {
+	paths := m.paths()
+	descriptions := make([]string, len(paths))
+	for i, path := range paths {
+		descriptions[i] = fmt.Sprintf("%v: %v", path, describeField(m.fields[path]))
+	}
+
+	return fmt.Sprintf("struct with fields (%v)", strings.Join(descriptions, ", "))
+}
+
+// ExplainMismatch returns why the first field that does not match did not match
+func (m *structFields) ExplainMismatch(actual interface{}) string {
+	if explanation := m.firstMismatch(actual); explanation != "" {
+		return explanation
+	}
+
+	return mismatch(m.Description(), actual)
+}
+
+// firstMismatch returns why the first field, in order of their paths, that
+// is not found or does not match did not match. It returns an empty string
+// if every field matches.
+func (m *structFields) firstMismatch(value interface{}) string {
+	for _, path := range m.paths() {
+		field, err := fieldByPath(reflect.ValueOf(value), path)
+		if err != nil {
+			return fmt.Sprintf("field %v: %v", path, err)
+		}
+
+		if !matchesField(m.fields[path], field) {
+			return fmt.Sprintf("field %v: %v", path, explainField(m.fields[path], field.Interface()))
+		}
+	}
+
+	return ""
+}
+
+// paths returns the paths of the fields in order
+func (m *structFields) paths() []string {
+	paths := make([]string, 0, len(m.fields))
+	for path := range m.fields {
+		paths = append(paths, path)
+	}
+
+	sort.Strings(paths)
+	return paths
+}
+
+// fieldByPath returns the exported field at the dot separated path. Pointers
+// and interfaces are followed on the way to the field, and fields promoted
+// from embedded structs are found by their own names.
+func fieldByPath(value reflect.Value, path string) (reflect.Value, error) {
+	names := strings.Split(path, ".")
+	for i := range names {
+		var err error
+		if value, err = fieldByName(value, names[:i+1]); err != nil {
+			return reflect.Value{}, err
+		}
+	}
+
+	return value, nil
+}
+
+// fieldByName returns the exported field named by the last of the names,
+// which lead to the field, from the struct the value holds
+func fieldByName(value reflect.Value, names []string) (reflect.Value, error) {
+	name := names[len(names)-1]
+	parent := "value"
+	if len(names) > 1 {
+		parent = strings.Join(names[:len(names)-1], ".")
+	}
+
+	structValue, ok := indirect(value)
+	if !ok {
+		return reflect.Value{}, fmt.Errorf("%v is nil", parent)
+	}
+
+	if structValue.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("%v is not a struct", parent)
+	}
+
+	structField, found := structValue.Type().FieldByName(name)
+	if !found {
+		return reflect.Value{}, fmt.Errorf("%v has no field %v", parent, name)
+	}
+
+	field, err := structValue.FieldByIndexErr(structField.Index)
+	if err != nil {
+		return reflect.Value{}, fmt.Errorf("%v is promoted from a nil embedded struct", strings.Join(names, "."))
+	}
+
+	if !field.CanInterface() {
+		return reflect.Value{}, fmt.Errorf("%v is not exported", strings.Join(names, "."))
+	}
+
+	return field, nil
+}
+
+// indirect follows pointers and interfaces to the value they hold. It returns
+// false if one of them is nil.
+func indirect(value reflect.Value) (reflect.Value, bool) {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return reflect.Value{}, false
+		}
+
+		value = value.Elem()
+	}
+
+	return value, true
+}
+
+// matchesField returns true if the field matches the matcher, or equals the
+// value when it is not a matcher
+func matchesField(expected interface{}, field reflect.Value) bool {
+	if matcher, ok := expected.(SupportedKindsMatcher); ok {
+		return matches(matcher, field.Interface())
+	}
+
+	if expected == nil {
+		return isNilValue(field)
+	}
+
+	return isEqualValue(reflect.ValueOf(expected), field)
+}
+
+// isNilValue returns true if the value is of a kind that can be nil and is nil
+func isNilValue(value reflect.Value) bool {
+	return isNillableKind(value.Kind()) && value.IsNil()
+}
+
+// isEqualValue returns true if the values are deeply equal. Values of a
+// different type are converted to the type of the actual value when they have
+// the same kind, such as a string for a named string type, or when both are
+// numbers and the conversion does not lose information.
+func isEqualValue(expected, actual reflect.Value) bool {
+	if reflect.DeepEqual(expected.Interface(), actual.Interface()) {
+		return true
+	}
+
+	if expected.Type() == actual.Type() || !expected.Type().ConvertibleTo(actual.Type()) {
+		return false
+	}
+
+	if expected.Kind() != actual.Kind() && (!isNumber(expected.Kind()) || !isNumber(actual.Kind())) {
+		return false
+	}
+
+	converted := expected.Convert(actual.Type())
+	if !reflect.DeepEqual(converted.Convert(expected.Type()).Interface(), expected.Interface()) {
+		return false
+	}
+
+	return reflect.DeepEqual(converted.Interface(), actual.Interface())
+}
+
+// isNumber returns true for the integer, float and complex kinds
+func isNumber(kind reflect.Kind) bool {
+	return kind >= reflect.Int && kind <= reflect.Complex128
+}
+
+// describeField returns the description of the matcher or value of a field
+func describeField(expected interface{}) string {
+	if matcher, ok := expected.(SupportedKindsMatcher); ok {
+		return DescriptionOf(matcher)
+	}
+
+	return fmt.Sprintf("%#v", expected)
+}
+
+// explainField returns why the field did not match its matcher or value
+func explainField(expected, actual interface{}) string {
+	if matcher, ok := expected.(SupportedKindsMatcher); ok {
+		return ExplainMismatch(matcher, actual)
+	}
+
+	return mismatch(describeField(expected), actual)
+}
+
+// Validate returns the error of the first field matcher, in order of their paths, that is not valid
+func (m *structFields) Validate() error {
+	values := make([]interface{}, 0, len(m.fields))
+	for _, path := range m.paths() {
+		values = append(values, m.fields[path])
+	}
+
+	return validateAll(values...)
+}
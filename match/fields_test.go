@@ -0,0 +1,186 @@
+package match
+
+import (
+	"reflect"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+)
+
+type status string
+
+type address struct {
+	Street string
+	Zip    string
+}
+
+type audit struct {
+	CreatedAt time.Time
+}
+
+type user struct {
+	Name    string
+	Age     int64
+	Status  status
+	Address *address
+	Tags    []string
+	audit
+	*Base
+	secret string
+}
+
+type Base struct {
+	ID int
+}
+
+type request struct {
+	User    user
+	Payload interface{}
+}
+
+var _ = Describe("structFields", func() {
+	var value request
+
+	BeforeEach(func() {
+		value = request{
+			User: user{
+				Name:    "Jane",
+				Age:     42,
+				Status:  "active",
+				Address: &address{Street: "Main", Zip: "12345"},
+				audit:   audit{CreatedAt: time.Now()},
+				Base:    &Base{ID: 7},
+				secret:  "shh",
+			},
+			Payload: &address{Zip: "54321"},
+		}
+	})
+
+	Describe("Fields", func() {
+		It("returns a structFields struct", func() {
+			actual := Fields(map[string]interface{}{"Name": "Jane"})
+
+			Expect(actual).To(BeAssignableToTypeOf(new(structFields)))
+		})
+	})
+
+	Describe("StructWith", func() {
+		It("returns a structFields struct", func() {
+			actual := StructWith("Name", "Jane")
+
+			Expect(actual).To(BeAssignableToTypeOf(new(structFields)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns the kinds that can hold a struct", func() {
+			actual := Fields(nil).SupportedKinds()
+
+			Expect(actual).To(Equal(
+				map[reflect.Kind]struct{}{
+					reflect.Interface: {},
+					reflect.Ptr:       {},
+					reflect.Struct:    {},
+				}))
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(fields map[string]interface{}, pointer bool) {
+			if pointer {
+				Expect(Fields(fields).Match(&value)).To(BeTrue())
+				return
+			}
+
+			Expect(Fields(fields).Match(value)).To(BeTrue())
+		},
+		Entry("when there are no fields", map[string]interface{}{}, false),
+		Entry("when a field equals the value", map[string]interface{}{"User.Name": "Jane"}, false),
+		Entry("when a field matches the matcher", map[string]interface{}{"User.Name": StringPrefix("J")}, false),
+		Entry("when the value is a pointer to the struct", map[string]interface{}{"User.Name": "Jane"}, true),
+		Entry("when the path goes through a pointer", map[string]interface{}{"User.Address.Zip": "12345"}, false),
+		Entry("when the path goes through an interface", map[string]interface{}{"Payload.Zip": "54321"}, false),
+		Entry("when the field is promoted from an embedded pointer", map[string]interface{}{"User.ID": 7}, false),
+		Entry("when the path goes through an embedded struct", map[string]interface{}{"User.Base.ID": 7}, false),
+		Entry("when a number converts to the type of the field", map[string]interface{}{"User.Age": 42}, false),
+		Entry("when a string converts to the named type of the field", map[string]interface{}{"User.Status": "active"}, false),
+		Entry("when a nil field is expected to be nil", map[string]interface{}{"User.Tags": nil}, false),
+		Entry("when every field matches", map[string]interface{}{"User.Name": "Jane", "User.Address": AnythingButNil()}, false),
+	)
+
+	DescribeTable("Match returns false",
+		func(fields map[string]interface{}) {
+			Expect(Fields(fields).Match(value)).To(BeFalse())
+		},
+		Entry("when a field does not equal the value", map[string]interface{}{"User.Name": "John"}),
+		Entry("when a field does not match the matcher", map[string]interface{}{"User.Name": StringPrefix("Jo")}),
+		Entry("when the field does not exist", map[string]interface{}{"User.Email": "jane@example.com"}),
+		Entry("when the field is not exported", map[string]interface{}{"User.secret": "shh"}),
+		Entry("when the path goes through a value that is not a struct", map[string]interface{}{"User.Name.First": "Jane"}),
+		Entry("when a number would lose information converting to the type of the field", map[string]interface{}{"User.Age": 42.5}),
+		Entry("when a value of another kind converts to the type of the field", map[string]interface{}{"User.Name": 74}),
+		Entry("when a field is not nil", map[string]interface{}{"User.Address": nil}),
+		Entry("when a matcher panics", map[string]interface{}{"User.Name": Nil()}),
+		Entry("when any of the fields does not match", map[string]interface{}{"User.Name": "Jane", "User.Age": 41}),
+	)
+
+	DescribeTable("Match returns false for",
+		func(actual interface{}) {
+			Expect(StructWith("Name", "Jane").Match(actual)).To(BeFalse())
+		},
+		Entry("nil", nil),
+		Entry("a nil pointer", (*user)(nil)),
+		Entry("a value that is not a struct", "Jane"),
+	)
+
+	It("does not match a field promoted from a nil embedded pointer", func() {
+		Expect(StructWith("ID", 7).Match(user{})).To(BeFalse())
+	})
+
+	It("matches a field promoted from an unexported embedded struct", func() {
+		Expect(StructWith("User.CreatedAt", value.User.CreatedAt).Match(value)).To(BeTrue())
+	})
+
+	Describe("Description", func() {
+		It("describes the fields in order of their paths", func() {
+			Expect(Fields(map[string]interface{}{"User.Name": StringPrefix("J"), "User.Age": 42}).(Describer).Description()).
+				To(Equal(`struct with fields (User.Age: 42, User.Name: string with prefix "J")`))
+		})
+	})
+
+	DescribeTable("ExplainMismatch",
+		func(fields map[string]interface{}, expected string) {
+			Expect(Fields(fields).(Describer).ExplainMismatch(value)).To(Equal(expected))
+		},
+		Entry("explains a value that is not equal", map[string]interface{}{"User.Name": "John"}, `field User.Name: expected "John" but got "Jane"`),
+		Entry("explains a matcher that does not match", map[string]interface{}{"User.Name": StringPrefix("Jo")}, `field User.Name: expected string with prefix "Jo" but got "Jane"`),
+		Entry("explains a missing field", map[string]interface{}{"User.Email": ""}, "field User.Email: User has no field Email"),
+		Entry("explains an unexported field", map[string]interface{}{"User.secret": ""}, "field User.secret: User.secret is not exported"),
+		Entry("explains a value that is not a struct", map[string]interface{}{"User.Name.First": ""}, "field User.Name.First: User.Name is not a struct"),
+	)
+
+	It("explains a nil pointer on the path", func() {
+		value.User.Address = nil
+
+		Expect(StructWith("User.Address.Zip", "12345").(Describer).ExplainMismatch(value)).
+			To(Equal("field User.Address.Zip: User.Address is nil"))
+	})
+
+	It("explains a field promoted from a nil embedded pointer", func() {
+		Expect(StructWith("ID", 7).(Describer).ExplainMismatch(user{})).
+			To(Equal("field ID: ID is promoted from a nil embedded struct"))
+	})
+
+	Describe("Validate", func() {
+		It("returns the error of a matcher that is not valid", func() {
+			err := Fields(map[string]interface{}{"Name": "[", "Path": StringMatching("[")}).(Validator).Validate()
+
+			Expect(err.Error()).To(Equal("error parsing regexp: missing closing ]: `[`"))
+		})
+
+		It("returns nil if every matcher is valid", func() {
+			Expect(Fields(map[string]interface{}{"Path": StringMatching("a")}).(Validator).Validate()).To(Succeed())
+		})
+	})
+})
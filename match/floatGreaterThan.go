@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -36,3 +37,13 @@ func (m *floatGreaterThan) Match(value interface{}) bool {
 		return false
 	}
 }
+
+// Description returns a description of the values the float greater than matcher matches
+func (m *floatGreaterThan) Description() string {
+	return fmt.Sprintf("float greater than %v", m.value)
+}
+
+// ExplainMismatch returns why the value did not match the float greater than matcher
+func (m *floatGreaterThan) ExplainMismatch(actual interface{}) string {
+	return mismatch(m.Description(), actual)
+}
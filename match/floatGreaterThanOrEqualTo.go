@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -36,3 +37,13 @@ func (m *floatGreaterThanOrEqualTo) Match(value interface{}) bool {
 		return false
 	}
 }
+
+// Description returns a description of the values the float greater than or equal to matcher matches
+func (m *floatGreaterThanOrEqualTo) Description() string {
+	return fmt.Sprintf("float greater than or equal to %v", m.value)
+}
+
+// ExplainMismatch returns why the value did not match the float greater than or equal to matcher
+func (m *floatGreaterThanOrEqualTo) ExplainMismatch(actual interface{}) string {
+	return mismatch(m.Description(), actual)
+}
@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -36,3 +37,13 @@ func (m *floatLessThan) Match(value interface{}) bool {
 		return false
 	}
 }
+
+// Description returns a description of the values the float less than matcher matches
+func (m *floatLessThan) Description() string {
+	return fmt.Sprintf("float less than %v", m.value)
+}
+
+// ExplainMismatch returns why the value did not match the float less than matcher
+func (m *floatLessThan) ExplainMismatch(actual interface{}) string {
+	return mismatch(m.Description(), actual)
+}
@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -36,3 +37,13 @@ func (m *floatLessThanOrEqualTo) Match(value interface{}) bool {
 		return false
 	}
 }
+
+// Description returns a description of the values the float less than or equal to matcher matches
+func (m *floatLessThanOrEqualTo) Description() string {
+	return fmt.Sprintf("float less than or equal to %v", m.value)
+}
+
+// ExplainMismatch returns why the value did not match the float less than or equal to matcher
+func (m *floatLessThanOrEqualTo) ExplainMismatch(actual interface{}) string {
+	return mismatch(m.Description(), actual)
+}
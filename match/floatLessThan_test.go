@@ -5,7 +5,6 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("floatLessThan", func() {
@@ -48,4 +47,16 @@ var _ = Describe("floatLessThan", func() {
 		Entry("when actual(float64) is the same as the expected", float64(8), float64(8)),
 		Entry("when actual is not an int", float64(10), "10"),
 	)
+
+	Describe("Description", func() {
+		It("describes the values the matcher matches", func() {
+			Expect(FloatLessThan(1.5).(Describer).Description()).To(Equal(`float less than 1.5`))
+		})
+	})
+
+	Describe("ExplainMismatch", func() {
+		It("explains that the value is not the described value", func() {
+			Expect(FloatLessThan(1.5).(Describer).ExplainMismatch(float64(1))).To(Equal(`expected float less than 1.5 but got 1`))
+		})
+	})
 })
@@ -0,0 +1,145 @@
+package match
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Func returns a new matcher that will match a value when the provided
+// predicate, a func(T) bool, returns true for it. The supported kinds are
+// inferred from T. A predicate that is not a func(T) bool fails the test when
+// the matcher is given to mocka, and so does a panic inside the predicate.
+func Func(fn interface{}) SupportedKindsMatcher {
+	predicate := reflect.ValueOf(fn)
+	if err := validatePredicate(predicate); err != nil {
+		return &funcMatcher{err: err}
+	}
+
+	return &funcMatcher{predicate: predicate}
+}
+
+// Typed returns a new matcher that will match a value when the provided
+// predicate returns true for it. It is the same as Func with the type of the
+// predicate checked by the compiler.
+func Typed[T any](fn func(T) bool) SupportedKindsMatcher {
+	return Func(fn)
+}
+
+// validatePredicate returns an error if the predicate is not a func(T) bool
+func validatePredicate(predicate reflect.Value) error {
+	if !predicate.IsValid() || predicate.Kind() == reflect.Func && predicate.IsNil() {
+		return errors.New("predicate is nil")
+	}
+
+	if !isPredicateType(predicate.Type()) {
+		return fmt.Errorf("expected a predicate of type func(T) bool, but received %v", predicate.Type())
+	}
+
+	return nil
+}
+
+// isPredicateType returns true if the type is a func(T) bool
+func isPredicateType(t reflect.Type) bool {
+	return t.Kind() == reflect.Func && t.NumIn() == 1 && !t.IsVariadic() && t.NumOut() == 1 && t.Out(0).Kind() == reflect.Bool
+}
+
+// PredicatePanic is the value a matcher built with Func or Typed panics with
+// when its predicate panics. mocka reports it to fail the test instead of
+// treating the value as not matching.
+type PredicatePanic struct {
+	// Predicate is the type of the predicate that panicked
+	Predicate reflect.Type
+
+	// Argument is the value the predicate was called with
+	Argument interface{}
+
+	// Value is the value the predicate panicked with
+	Value interface{}
+}
+
+// Error returns a description of the panic
+func (p *PredicatePanic) Error() string {
+	return fmt.Sprintf("predicate %v panicked with %v for %#v", p.Predicate, p.Value, p.Argument)
+}
+
+type funcMatcher struct {
+	predicate reflect.Value
+	err       error
+}
+
+// SupportedKinds returns the kind of the predicate's argument, or every kind
+// if the argument is an interface
+func (m *funcMatcher) SupportedKinds() map[reflect.Kind]struct{} {
+	if m.err != nil {
+		return map[reflect.Kind]struct{}{}
+	}
+
+	argumentType := m.predicate.Type().In(0)
+	if argumentType.Kind() == reflect.Interface {
+		return Anything().SupportedKinds()
+	}
+
+	return map[reflect.Kind]struct{}{
+		argumentType.Kind(): {},
+		reflect.Interface:   {},
+	}
+}
+
+// Match returns true if the value can be given to the predicate and the
+// predicate returns true for it. A panic inside the predicate is raised again
+// as a *PredicatePanic.
+func (m *funcMatcher) Match(value interface{}) bool {
+	if m.err != nil {
+		return false
+	}
+
+	argument, ok := m.toArgument(value)
+	if !ok {
+		return false
+	}
+
+	return m.call(argument, value)
+}
+
+// Validate returns the error of a predicate that is not a func(T) bool
+func (m *funcMatcher) Validate() error {
+	return m.err
+}
+
+// Description returns a description of the values the func matcher matches
+func (m *funcMatcher) Description() string {
+	if m.err != nil {
+		return "value matching an invalid predicate"
+	}
+
+	return fmt.Sprintf("value matching %v", m.predicate.Type())
+}
+
+// ExplainMismatch returns why the value did not match the func matcher
+func (m *funcMatcher) ExplainMismatch(actual interface{}) string {
+	return mismatch(m.Description(), actual)
+}
+
+// toArgument returns the value as an argument for the predicate. It returns
+// false if the value can not be given to the predicate.
+func (m *funcMatcher) toArgument(value interface{}) (reflect.Value, bool) {
+	argumentType := m.predicate.Type().In(0)
+	if value == nil {
+		return reflect.Zero(argumentType), isNillableKind(argumentType.Kind())
+	}
+
+	argument := reflect.ValueOf(value)
+	return argument, argument.Type().AssignableTo(argumentType)
+}
+
+// call returns the result of the predicate for the argument
+func (m *funcMatcher) call(argument reflect.Value, value interface{}) bool {
+	defer func() {
+		if r := recover(); r != nil {
+			panic(&PredicatePanic{Predicate: m.predicate.Type(), Argument: value, Value: r})
+		}
+	}()
+
+	return m.predicate.Call([]reflect.Value{argument})[0].Bool()
+}
@@ -0,0 +1,137 @@
+package match
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+)
+
+// recoverPanic returns the value the function panics with, or nil
+func recoverPanic(fn func()) (r interface{}) {
+	defer func() {
+		r = recover()
+	}()
+
+	fn()
+	return nil
+}
+
+var _ = Describe("funcMatcher", func() {
+	isShort := func(s string) bool {
+		return len(s) < 5
+	}
+
+	Describe("Func", func() {
+		It("returns a funcMatcher struct", func() {
+			actual := Func(isShort)
+
+			Expect(actual).To(BeAssignableToTypeOf(new(funcMatcher)))
+		})
+
+		It("is valid for a func(T) bool", func() {
+			Expect(Func(isShort).(Validator).Validate()).To(Succeed())
+		})
+	})
+
+	DescribeTable("Func is not valid",
+		func(fn interface{}, expected string) {
+			err := Func(fn).(Validator).Validate()
+
+			Expect(err.Error()).To(Equal(expected))
+		},
+		Entry("for nil", nil, "predicate is nil"),
+		Entry("for a nil func", (func(string) bool)(nil), "predicate is nil"),
+		Entry("for a value that is not a func", "hello", "expected a predicate of type func(T) bool, but received string"),
+		Entry("for a func without arguments", func() bool { return true }, "expected a predicate of type func(T) bool, but received func() bool"),
+		Entry("for a func with a variadic argument", func(...string) bool { return true }, "expected a predicate of type func(T) bool, but received func(...string) bool"),
+		Entry("for a func that does not return a bool", func(string) int { return 0 }, "expected a predicate of type func(T) bool, but received func(string) int"),
+	)
+
+	Describe("Typed", func() {
+		It("returns a funcMatcher struct", func() {
+			actual := Typed(isShort)
+
+			Expect(actual).To(BeAssignableToTypeOf(new(funcMatcher)))
+		})
+
+		It("is not valid for a nil predicate", func() {
+			err := Typed[string](nil).(Validator).Validate()
+
+			Expect(err.Error()).To(Equal("predicate is nil"))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns the kind of the argument of the predicate", func() {
+			actual := Func(isShort).SupportedKinds()
+
+			Expect(actual).To(Equal(
+				map[reflect.Kind]struct{}{
+					reflect.String:    {},
+					reflect.Interface: {},
+				}))
+		})
+
+		It("returns every kind if the argument of the predicate is an interface", func() {
+			actual := Typed(func(error) bool { return true }).SupportedKinds()
+
+			Expect(actual).To(Equal(Anything().SupportedKinds()))
+		})
+
+		It("returns no kinds for an invalid predicate", func() {
+			actual := Func("hello").SupportedKinds()
+
+			Expect(actual).To(Equal(map[reflect.Kind]struct{}{}))
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(matcher SupportedKindsMatcher, actual interface{}) {
+			Expect(matcher.Match(actual)).To(BeTrue())
+		},
+		Entry("when the predicate returns true", Func(isShort), "hi"),
+		Entry("when the value implements the argument of the predicate", Typed(func(err error) bool { return err.Error() == "ope" }), errors.New("ope")),
+		Entry("when the value is nil and the argument of the predicate can be nil", Typed(func(err error) bool { return err == nil }), nil),
+	)
+
+	DescribeTable("Match returns false",
+		func(matcher SupportedKindsMatcher, actual interface{}) {
+			Expect(matcher.Match(actual)).To(BeFalse())
+		},
+		Entry("when the predicate returns false", Func(isShort), "hello world"),
+		Entry("when the value can not be given to the predicate", Func(isShort), 12),
+		Entry("when the value is nil and the argument of the predicate can not be nil", Func(isShort), nil),
+		Entry("when the predicate is not valid", Func("hello"), "hello"),
+	)
+
+	Describe("Match", func() {
+		It("panics with a *PredicatePanic if the predicate panics", func() {
+			matcher := Typed(func(s string) bool { return strings.Repeat(s, -1) == "" })
+
+			r := recoverPanic(func() { matcher.Match("hi") })
+
+			Expect(r).To(BeAssignableToTypeOf(new(PredicatePanic)))
+			Expect(r.(*PredicatePanic).Error()).To(Equal(`predicate func(string) bool panicked with strings: negative Repeat count for "hi"`))
+		})
+	})
+
+	Describe("Description", func() {
+		It("describes the values the matcher matches", func() {
+			Expect(Func(isShort).(Describer).Description()).To(Equal("value matching func(string) bool"))
+		})
+
+		It("describes an invalid predicate", func() {
+			Expect(Func("hello").(Describer).Description()).To(Equal("value matching an invalid predicate"))
+		})
+	})
+
+	Describe("ExplainMismatch", func() {
+		It("explains that the value is not the described value", func() {
+			Expect(Func(isShort).(Describer).ExplainMismatch("hello world")).
+				To(Equal(`expected value matching func(string) bool but got "hello world"`))
+		})
+	})
+})
@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -47,3 +48,13 @@ func (m *implementerOf) Match(value interface{}) bool {
 
 	return actualType.Implements(expectedType.Elem())
 }
+
+// Description returns a description of the values the implementer of matcher matches
+func (m *implementerOf) Description() string {
+	return fmt.Sprintf("implementer of %v", pointedType(m.value))
+}
+
+// ExplainMismatch returns why the value did not match the implementer of matcher
+func (m *implementerOf) ExplainMismatch(actual interface{}) string {
+	return mismatch(m.Description(), actual)
+}
@@ -5,7 +5,6 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("implementerOf", func() {
@@ -46,4 +45,16 @@ var _ = Describe("implementerOf", func() {
 		Entry("when value is not an interface", new(SupportedKindsMatcher), make(chan int)),
 		Entry("when value does not implement the interface", new(SupportedKindsMatcher), &struct{}{}),
 	)
+
+	Describe("Description", func() {
+		It("describes the values the matcher matches", func() {
+			Expect(ImplementerOf((*error)(nil)).(Describer).Description()).To(Equal(`implementer of error`))
+		})
+	})
+
+	Describe("ExplainMismatch", func() {
+		It("explains that the value is not the described value", func() {
+			Expect(ImplementerOf((*error)(nil)).(Describer).ExplainMismatch("hello")).To(Equal(`expected implementer of error but got "hello"`))
+		})
+	})
 })
@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -45,3 +46,13 @@ func (m *intGreaterThan) Match(value interface{}) bool {
 		return false
 	}
 }
+
+// Description returns a description of the values the int greater than matcher matches
+func (m *intGreaterThan) Description() string {
+	return fmt.Sprintf("int greater than %v", m.value)
+}
+
+// ExplainMismatch returns why the value did not match the int greater than matcher
+func (m *intGreaterThan) ExplainMismatch(actual interface{}) string {
+	return mismatch(m.Description(), actual)
+}
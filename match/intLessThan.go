@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -45,3 +46,13 @@ func (m *intLessThan) Match(value interface{}) bool {
 		return false
 	}
 }
+
+// Description returns a description of the values the int less than matcher matches
+func (m *intLessThan) Description() string {
+	return fmt.Sprintf("int less than %v", m.value)
+}
+
+// ExplainMismatch returns why the value did not match the int less than matcher
+func (m *intLessThan) ExplainMismatch(actual interface{}) string {
+	return mismatch(m.Description(), actual)
+}
@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -45,3 +46,13 @@ func (m *intLessThanOrEqualTo) Match(value interface{}) bool {
 		return false
 	}
 }
+
+// Description returns a description of the values the int less than or equal to matcher matches
+func (m *intLessThanOrEqualTo) Description() string {
+	return fmt.Sprintf("int less than or equal to %v", m.value)
+}
+
+// ExplainMismatch returns why the value did not match the int less than or equal to matcher
+func (m *intLessThanOrEqualTo) ExplainMismatch(actual interface{}) string {
+	return mismatch(m.Description(), actual)
+}
@@ -5,7 +5,6 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("intLessThanOrEqualTo", func() {
@@ -60,4 +59,16 @@ var _ = Describe("intLessThanOrEqualTo", func() {
 		Entry("when actual(int64) is greater than expected", int64(5), int64(8)),
 		Entry("when actual is not an int", int64(10), "10"),
 	)
+
+	Describe("Description", func() {
+		It("describes the values the matcher matches", func() {
+			Expect(IntLessThanOrEqualTo(2).(Describer).Description()).To(Equal(`int less than or equal to 2`))
+		})
+	})
+
+	Describe("ExplainMismatch", func() {
+		It("explains that the value is not the described value", func() {
+			Expect(IntLessThanOrEqualTo(2).(Describer).ExplainMismatch(1)).To(Equal(`expected int less than or equal to 2 but got 1`))
+		})
+	})
 })
@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -54,3 +55,13 @@ func (m *keysContaining) Match(value interface{}) bool {
 		return false
 	}
 }
+
+// Description returns a description of the values the keys containing matcher matches
+func (m *keysContaining) Description() string {
+	return fmt.Sprintf("map with keys containing (%v)", describeValues(m.keys))
+}
+
+// ExplainMismatch returns why the value did not match the keys containing matcher
+func (m *keysContaining) ExplainMismatch(actual interface{}) string {
+	return mismatch(m.Description(), actual)
+}
@@ -5,7 +5,6 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("keysContaining", func() {
@@ -56,4 +55,16 @@ var _ = Describe("keysContaining", func() {
 			5: "5",
 		}),
 	)
+
+	Describe("Description", func() {
+		It("describes the values the matcher matches", func() {
+			Expect(KeysContaining("a").(Describer).Description()).To(Equal(`map with keys containing ("a")`))
+		})
+	})
+
+	Describe("ExplainMismatch", func() {
+		It("explains that the value is not the described value", func() {
+			Expect(KeysContaining("a").(Describer).ExplainMismatch(map[string]int{"b": 1})).To(Equal(`expected map with keys containing ("a") but got map[string]int{"b":1}`))
+		})
+	})
 })
@@ -1,6 +1,9 @@
 package match
 
-import "reflect"
+import (
+	"fmt"
+	"reflect"
+)
 
 // LengthOf returns a new matcher that will match the length
 // of strings, slices, arrays, and maps
@@ -39,3 +42,13 @@ func (m *lengthOf) Match(value interface{}) bool {
 		return false
 	}
 }
+
+// Description returns a description of the values the length of matcher matches
+func (m *lengthOf) Description() string {
+	return fmt.Sprintf("value with length %v", m.length)
+}
+
+// ExplainMismatch returns why the value did not match the length of matcher
+func (m *lengthOf) ExplainMismatch(actual interface{}) string {
+	return mismatch(m.Description(), actual)
+}
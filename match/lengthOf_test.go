@@ -5,7 +5,6 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("lengthOf", func() {
@@ -56,4 +55,16 @@ var _ = Describe("lengthOf", func() {
 		Entry("when length does not matches for string", 8, "hello"),
 		Entry("when length does not matches for map", 2, map[int]string{0: "a"}),
 	)
+
+	Describe("Description", func() {
+		It("describes the values the matcher matches", func() {
+			Expect(LengthOf(2).(Describer).Description()).To(Equal(`value with length 2`))
+		})
+	})
+
+	Describe("ExplainMismatch", func() {
+		It("explains that the value is not the described value", func() {
+			Expect(LengthOf(2).(Describer).ExplainMismatch("abc")).To(Equal(`expected value with length 2 but got "abc"`))
+		})
+	})
 })
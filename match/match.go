@@ -3,7 +3,9 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
+	"strings"
 )
 
 // SupportedKindsMatcher describes the functionality of a custom argument matcher for mocka
@@ -14,3 +16,150 @@ type SupportedKindsMatcher interface {
 	// Match return true is the match was successful; otherwise false
 	Match(interface{}) bool
 }
+
+// Describer describes the functionality of a matcher that can explain itself.
+// It is optional for custom matchers; mocka uses it in failure messages when
+// it is implemented.
+type Describer interface {
+	// Description returns a human readable description of the values the matcher matches
+	Description() string
+
+	// ExplainMismatch returns a human readable explanation of why the value did not match
+	ExplainMismatch(actual interface{}) string
+}
+
+// Validator describes the functionality of a matcher that can be invalid,
+// such as a matcher built from a pattern that does not compile. It is
+// optional for custom matchers; mocka fails the test with the error when an
+// invalid matcher is given to it.
+type Validator interface {
+	// Validate returns an error if the matcher is not valid; otherwise nil
+	Validate() error
+}
+
+// TypeSupporter describes the functionality of a matcher that supports types
+// by more than their kind, such as every type that implements fmt.Stringer.
+// It is optional for custom matchers; when it is implemented mocka uses it in
+// place of SupportedKinds to decide whether the matcher can be used for an argument.
+type TypeSupporter interface {
+	// SupportsType returns true if the matcher supports values of the type
+	SupportsType(t reflect.Type) bool
+}
+
+// Supports returns true if the matcher supports values of the type. Matchers
+// that do not implement TypeSupporter support the types of their supported kinds.
+func Supports(matcher SupportedKindsMatcher, t reflect.Type) bool {
+	if supporter, ok := matcher.(TypeSupporter); ok {
+		return supporter.SupportsType(t)
+	}
+
+	_, ok := matcher.SupportedKinds()[t.Kind()]
+	return ok
+}
+
+// DescriptionOf returns the description of the matcher. Matchers that do not
+// implement Describer are described by their type.
+func DescriptionOf(matcher SupportedKindsMatcher) string {
+	if describer, ok := matcher.(Describer); ok {
+		return describer.Description()
+	}
+
+	return fmt.Sprintf("value matching %T", matcher)
+}
+
+// ExplainMismatch returns why the value did not match the matcher. Matchers
+// that do not implement Describer are explained by their type.
+func ExplainMismatch(matcher SupportedKindsMatcher, actual interface{}) string {
+	if describer, ok := matcher.(Describer); ok {
+		return describer.ExplainMismatch(actual)
+	}
+
+	return mismatch(DescriptionOf(matcher), actual)
+}
+
+// validateAll returns the error of the first value that is an invalid matcher
+func validateAll(values ...interface{}) error {
+	for _, value := range values {
+		if validator, ok := value.(Validator); ok {
+			if err := validator.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateMatchers returns the error of the first matcher that is not valid
+func validateMatchers(matchers []SupportedKindsMatcher) error {
+	for _, matcher := range matchers {
+		if err := validateAll(matcher); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mismatch returns the explanation that the value is not the described value
+func mismatch(description string, actual interface{}) string {
+	return fmt.Sprintf("expected %v but got %#v", description, actual)
+}
+
+// describeAll returns the descriptions of the matchers as a list
+func describeAll(matchers []SupportedKindsMatcher) string {
+	descriptions := make([]string, len(matchers))
+	for i, matcher := range matchers {
+		descriptions[i] = DescriptionOf(matcher)
+	}
+
+	return strings.Join(descriptions, ", ")
+}
+
+// describeValues returns the values as a list
+func describeValues(values []interface{}) string {
+	descriptions := make([]string, len(values))
+	for i, value := range values {
+		descriptions[i] = fmt.Sprintf("%#v", value)
+	}
+
+	return strings.Join(descriptions, ", ")
+}
+
+// pointedType returns the type the value points to, or the type of the
+// value if it is not a pointer
+func pointedType(value interface{}) reflect.Type {
+	valueType := reflect.TypeOf(value)
+	if valueType != nil && valueType.Kind() == reflect.Ptr {
+		return valueType.Elem()
+	}
+
+	return valueType
+}
+
+// isNillableKind returns true for the kinds that can be nil
+func isNillableKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+		return true
+	default:
+		return false
+	}
+}
+
+// matches returns true if the matcher matches the value. A matcher that
+// panics, such as when it is given a value of a kind it does not support,
+// does not match. A *PredicatePanic is raised again so it can fail the test.
+func matches(matcher SupportedKindsMatcher, value interface{}) (isMatch bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(*PredicatePanic); ok {
+				panic(r)
+			}
+
+			isMatch = false
+		}
+	}()
+
+	return matcher.Match(value)
+}
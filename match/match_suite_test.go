@@ -4,12 +4,23 @@ import (
 	"testing"
 
 	. "github.com/onsi/ginkgo"
-	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega"
 	"github.com/onsi/gomega/format"
 )
 
+// The gomega functions used by the tests are declared here instead of
+// dot-importing gomega, whose Not would conflict with the Not matcher.
+var (
+	Expect               = gomega.Expect
+	Equal                = gomega.Equal
+	BeTrue               = gomega.BeTrue
+	BeFalse              = gomega.BeFalse
+	BeAssignableToTypeOf = gomega.BeAssignableToTypeOf
+	Succeed              = gomega.Succeed
+)
+
 func TestMocka(t *testing.T) {
-	RegisterFailHandler(Fail)
+	gomega.RegisterFailHandler(Fail)
 	format.TruncatedDiff = false
 	RunSpecs(t, "Match Testing Suite")
 }
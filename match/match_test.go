@@ -0,0 +1,62 @@
+package match
+
+import (
+	"reflect"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+)
+
+var _ = Describe("match", func() {
+	Describe("DescriptionOf", func() {
+		It("returns the description of a Describer", func() {
+			Expect(DescriptionOf(StringPrefix("/api"))).To(Equal(`string with prefix "/api"`))
+		})
+
+		It("describes other matchers by their type", func() {
+			Expect(DescriptionOf(mockMatcher{})).To(Equal("value matching match.mockMatcher"))
+		})
+	})
+
+	Describe("Supports", func() {
+		It("returns true for the types of the supported kinds of other matchers", func() {
+			Expect(Supports(StringPrefix("/"), reflect.TypeOf(""))).To(BeTrue())
+			Expect(Supports(StringPrefix("/"), reflect.TypeOf(0))).To(BeFalse())
+		})
+
+		It("asks a TypeSupporter, including through AllOf, AnyOf and Not", func() {
+			month := reflect.TypeOf(time.January)
+
+			Expect(Supports(StringMatching("^J"), month)).To(BeTrue())
+			Expect(Supports(AllOf(StringMatching("^J"), Anything()), month)).To(BeTrue())
+			Expect(Supports(AllOf(StringMatching("^J"), StringPrefix("J")), month)).To(BeFalse())
+			Expect(Supports(AnyOf(StringMatching("^J"), IntGreaterThan(0)), reflect.TypeOf(0))).To(BeTrue())
+			Expect(Supports(Not(StringMatching("^J")), month)).To(BeTrue())
+			Expect(Supports(Not(StringMatching("^J")), reflect.TypeOf(0))).To(BeFalse())
+		})
+	})
+
+	Describe("ExplainMismatch", func() {
+		It("returns the explanation of a Describer", func() {
+			Expect(ExplainMismatch(SliceOf(Exactly(1)), []int{2})).To(Equal("element 0: expected 1 but got 2"))
+		})
+
+		It("explains the mismatch of other matchers by their type", func() {
+			Expect(ExplainMismatch(mockMatcher{}, 2)).To(Equal("expected value matching match.mockMatcher but got 2"))
+		})
+	})
+
+	Describe("matches", func() {
+		It("returns false if the matcher panics", func() {
+			Expect(matches(Nil(), "hello")).To(BeFalse())
+		})
+
+		It("panics again with a *PredicatePanic from inside the matcher", func() {
+			matcher := Typed(func(string) bool { panic("ope") })
+
+			r := recoverPanic(func() { matches(matcher, "hello") })
+
+			Expect(r).To(BeAssignableToTypeOf(new(PredicatePanic)))
+		})
+	})
+})
@@ -32,3 +32,13 @@ func (nilMatcher) Match(value interface{}) bool {
 	v := reflect.ValueOf(value)
 	return v.IsValid() && v.IsNil()
 }
+
+// Description returns a description of the values the nil matcher matches
+func (nilMatcher) Description() string {
+	return "nil"
+}
+
+// ExplainMismatch returns why the value did not match the nil matcher
+func (m *nilMatcher) ExplainMismatch(actual interface{}) string {
+	return mismatch(m.Description(), actual)
+}
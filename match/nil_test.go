@@ -5,7 +5,6 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("nil", func() {
@@ -57,4 +56,16 @@ var _ = Describe("nil", func() {
 		Entry("with non nil pointer", &nilMatcher{}),
 		Entry("with non nil slice", []string{}),
 	)
+
+	Describe("Description", func() {
+		It("describes the values the matcher matches", func() {
+			Expect(Nil().(Describer).Description()).To(Equal(`nil`))
+		})
+	})
+
+	Describe("ExplainMismatch", func() {
+		It("explains that the value is not the described value", func() {
+			Expect(Nil().(Describer).ExplainMismatch("hello")).To(Equal(`expected nil but got "hello"`))
+		})
+	})
 })
@@ -0,0 +1,43 @@
+package match
+
+import "reflect"
+
+// Not returns a new matcher that will match when the provided matcher does
+// not match. It supports the same kinds as the provided matcher.
+func Not(matcher SupportedKindsMatcher) SupportedKindsMatcher {
+	return &not{matcher}
+}
+
+type not struct {
+	matcher SupportedKindsMatcher
+}
+
+// SupportedKinds returns the kinds supported by the negated matcher
+func (m *not) SupportedKinds() map[reflect.Kind]struct{} {
+	return m.matcher.SupportedKinds()
+}
+
+// SupportsType returns true if the negated matcher supports the type
+func (m *not) SupportsType(t reflect.Type) bool {
+	return Supports(m.matcher, t)
+}
+
+// Match returns true if the negated matcher does not match the value
+func (m *not) Match(value interface{}) bool {
+	return !matches(m.matcher, value)
+}
+
+// Description returns a description of the values the negated matcher does not match
+func (m *not) Description() string {
+	return "not " + DescriptionOf(m.matcher)
+}
+
+// ExplainMismatch returns why the value did not match the not matcher
+func (m *not) ExplainMismatch(actual interface{}) string {
+	return mismatch(m.Description(), actual)
+}
+
+// Validate returns the error of the negated matcher if it is not valid
+func (m *not) Validate() error {
+	return validateAll(m.matcher)
+}
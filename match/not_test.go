@@ -0,0 +1,69 @@
+package match
+
+import (
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+)
+
+var _ = Describe("not", func() {
+	Describe("Not", func() {
+		It("returns a not struct", func() {
+			actual := Not(Anything())
+
+			Expect(actual).To(BeAssignableToTypeOf(new(not)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns the kinds supported by the negated matcher", func() {
+			actual := Not(StringPrefix("")).SupportedKinds()
+
+			Expect(actual).To(Equal(
+				map[reflect.Kind]struct{}{
+					reflect.String: {},
+				}))
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(matcher SupportedKindsMatcher, actual interface{}) {
+			Expect(matcher.Match(actual)).To(BeTrue())
+		},
+		Entry("when the negated matcher does not match", Not(StringContaining("admin")), "/api/users"),
+		Entry("when the negated matcher panics", Not(Nil()), "/api"),
+	)
+
+	DescribeTable("Match returns false",
+		func(matcher SupportedKindsMatcher, actual interface{}) {
+			Expect(matcher.Match(actual)).To(BeFalse())
+		},
+		Entry("when the negated matcher matches", Not(StringContaining("admin")), "/api/admin"),
+	)
+
+	Describe("Description", func() {
+		It("describes the negated matcher", func() {
+			Expect(Not(StringPrefix("/api")).(Describer).Description()).To(Equal(`not string with prefix "/api"`))
+		})
+	})
+
+	Describe("ExplainMismatch", func() {
+		It("explains that the value matched the negated matcher", func() {
+			Expect(Not(StringPrefix("/api")).(Describer).ExplainMismatch("/api/users")).
+				To(Equal(`expected not string with prefix "/api" but got "/api/users"`))
+		})
+	})
+
+	Describe("Validate", func() {
+		It("returns the error of a matcher that is not valid", func() {
+			err := Not(StringMatching("[")).(Validator).Validate()
+
+			Expect(err.Error()).To(Equal("error parsing regexp: missing closing ]: `[`"))
+		})
+
+		It("returns nil if every matcher is valid", func() {
+			Expect(Not(StringMatching("a")).(Validator).Validate()).To(Succeed())
+		})
+	})
+})
@@ -2,8 +2,17 @@ package match
 
 import "reflect"
 
-// Priority returns the matchers priority to be compared against
+// Priority returns the matchers priority to be compared against. AllOf has
+// the priority of its highest priority matcher and AnyOf the priority of
+// its lowest priority matcher.
 func Priority(m SupportedKindsMatcher) float64 {
+	switch c := m.(type) {
+	case *allOf:
+		return highestPriority(c.matchers)
+	case *anyOf:
+		return lowestPriority(c.matchers)
+	}
+
 	if p, exists := priorities[reflect.TypeOf(m)]; exists {
 		return p
 	}
@@ -36,6 +45,7 @@ var priorities = map[reflect.Type]float64{
 	// string matchers
 	reflect.TypeOf(new(stringPrefix)):     11,
 	reflect.TypeOf(new(stringSuffix)):     10,
+	reflect.TypeOf(new(stringMatching)):   9.5,
 	reflect.TypeOf(new(stringContaining)): 9,
 
 	// multi-purpse matchers
@@ -46,10 +56,47 @@ var priorities = map[reflect.Type]float64{
 	reflect.TypeOf(new(keysContaining)):     6,
 	reflect.TypeOf(new(elementsContaining)): 5,
 
+	// struct matchers
+	reflect.TypeOf(new(structFields)): 4.5,
+
 	// type matchers
 	reflect.TypeOf(new(implementerOf)):  4,
 	reflect.TypeOf(new(convertibleTo)):  3,
 	reflect.TypeOf(new(typeOf)):         2,
 	reflect.TypeOf(new(anythingButNil)): 1,
-	reflect.TypeOf(new(anything)):       0,
+
+	// logical matchers
+	reflect.TypeOf(new(not)): 0.5,
+
+	reflect.TypeOf(new(anything)): 0,
+}
+
+// highestPriority returns the highest priority of the matchers,
+// or the priority of Anything without matchers
+func highestPriority(matchers []SupportedKindsMatcher) float64 {
+	highest := priorities[reflect.TypeOf(new(anything))]
+	for _, m := range matchers {
+		if p := Priority(m); p > highest {
+			highest = p
+		}
+	}
+
+	return highest
+}
+
+// lowestPriority returns the lowest priority of the matchers,
+// or the priority of Anything without matchers
+func lowestPriority(matchers []SupportedKindsMatcher) float64 {
+	if len(matchers) == 0 {
+		return priorities[reflect.TypeOf(new(anything))]
+	}
+
+	lowest := Priority(matchers[0])
+	for _, m := range matchers[1:] {
+		if p := Priority(m); p < lowest {
+			lowest = p
+		}
+	}
+
+	return lowest
 }
@@ -5,7 +5,6 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("priority", func() {
@@ -13,7 +12,7 @@ var _ = Describe("priority", func() {
 		func(matcher SupportedKindsMatcher, actual float64) {
 			Expect(Priority(matcher)).To(Equal(actual))
 		},
-		Entry("priority for custom matchers", new(mockMatcher), float64(27)),
+		Entry("priority for custom matchers", new(mockMatcher), float64(30)),
 		Entry("priority for the exactly matcher", new(exactly), float64(25)),
 		Entry("priority for the nilMatcher matcher", new(nilMatcher), float64(24)),
 		Entry("priority for the floatGreaterThan matcher", new(floatGreaterThan), float64(23)),
@@ -30,16 +29,24 @@ var _ = Describe("priority", func() {
 		Entry("priority for the uintLessThanOrEqualTo matcher", new(uintLessThanOrEqualTo), float64(12)),
 		Entry("priority for the stringPrefix matcher", new(stringPrefix), float64(11)),
 		Entry("priority for the stringSuffix matcher", new(stringSuffix), float64(10)),
+		Entry("priority for the stringMatching matcher", new(stringMatching), float64(9.5)),
 		Entry("priority for the stringContaining matcher", new(stringContaining), float64(9)),
 		Entry("priority for the lengthOf matcher", new(lengthOf), float64(8)),
 		Entry("priority for the empty matcher", new(empty), float64(7)),
 		Entry("priority for the keysContaining matcher", new(keysContaining), float64(6)),
 		Entry("priority for the elementsContaining matcher", new(elementsContaining), float64(5)),
+		Entry("priority for the structFields matcher", new(structFields), float64(4.5)),
 		Entry("priority for the implementerOf matcher", new(implementerOf), float64(4)),
 		Entry("priority for the convertibleTo matcher", new(convertibleTo), float64(3)),
 		Entry("priority for the typeOf matcher", new(typeOf), float64(2)),
 		Entry("priority for the anythingButNil matcher", new(anythingButNil), float64(1)),
+		Entry("priority for the not matcher", new(not), float64(0.5)),
 		Entry("priority for the anything matcher", new(anything), float64(0)),
+		Entry("priority for the allOf matcher", AllOf(StringContaining("a"), Exactly("a")), float64(25)),
+		Entry("priority for the allOf matcher without matchers", AllOf(), float64(0)),
+		Entry("priority for the anyOf matcher", AnyOf(StringContaining("a"), Exactly("a")), float64(9)),
+		Entry("priority for the anyOf matcher without matchers", AnyOf(), float64(0)),
+		Entry("priority for nested logical matchers", AllOf(AnyOf(Nil(), Empty()), Not(Exactly("a"))), float64(7)),
 	)
 })
 
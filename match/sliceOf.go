@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -36,3 +37,32 @@ func (m *sliceOf) Match(value interface{}) bool {
 
 	return true
 }
+
+// Description returns a description of the slices the sliceOf matcher matches
+func (m *sliceOf) Description() string {
+	return fmt.Sprintf("slice of (%v)", describeAll(m.matchers))
+}
+
+// ExplainMismatch returns why the first element that does not match its
+// respective matcher did not match. Values that are not slices or arrays of
+// the same length are explained as a whole.
+func (m *sliceOf) ExplainMismatch(actual interface{}) string {
+	slice := reflect.ValueOf(actual)
+	if slice.Kind() != reflect.Slice && slice.Kind() != reflect.Array || slice.Len() != len(m.matchers) {
+		return mismatch(m.Description(), actual)
+	}
+
+	for i, matcher := range m.matchers {
+		element := slice.Index(i).Interface()
+		if !matches(matcher, element) {
+			return fmt.Sprintf("element %v: %v", i, ExplainMismatch(matcher, element))
+		}
+	}
+
+	return mismatch(m.Description(), actual)
+}
+
+// Validate returns the error of the first element matcher that is not valid
+func (m *sliceOf) Validate() error {
+	return validateMatchers(m.matchers)
+}
@@ -5,7 +5,6 @@ import (
 	"reflect"
 
 	. "github.com/onsi/ginkgo"
-	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("sliceOf", func() {
@@ -44,4 +43,35 @@ var _ = Describe("sliceOf", func() {
 			Expect(matcher.Match([]interface{}{1, errors.New("a"), "A"})).To(BeFalse())
 		})
 	})
+
+	Describe("Description", func() {
+		It("describes the matcher of every element", func() {
+			Expect(SliceOf(Exactly(1), IntGreaterThan(1)).(Describer).Description()).
+				To(Equal("slice of (1, int greater than 1)"))
+		})
+	})
+
+	Describe("ExplainMismatch", func() {
+		It("explains the mismatch of the first element that does not match", func() {
+			Expect(SliceOf(Exactly(1), IntGreaterThan(1)).(Describer).ExplainMismatch([]int{1, 0})).
+				To(Equal("element 1: expected int greater than 1 but got 0"))
+		})
+
+		It("explains a value of a different length as a whole", func() {
+			Expect(SliceOf(Exactly(1)).(Describer).ExplainMismatch([]int{1, 2})).
+				To(Equal("expected slice of (1) but got []int{1, 2}"))
+		})
+	})
+
+	Describe("Validate", func() {
+		It("returns the error of a matcher that is not valid", func() {
+			err := SliceOf(Anything(), StringMatching("[")).(Validator).Validate()
+
+			Expect(err.Error()).To(Equal("error parsing regexp: missing closing ]: `[`"))
+		})
+
+		It("returns nil if every matcher is valid", func() {
+			Expect(SliceOf(Anything(), StringMatching("a")).(Validator).Validate()).To(Succeed())
+		})
+	})
 })
@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 	"strings"
 )
@@ -34,3 +35,13 @@ func (m *stringContaining) Match(value interface{}) bool {
 		return false
 	}
 }
+
+// Description returns a description of the values the string containing matcher matches
+func (m *stringContaining) Description() string {
+	return fmt.Sprintf("string containing %q", m.substring)
+}
+
+// ExplainMismatch returns why the value did not match the string containing matcher
+func (m *stringContaining) ExplainMismatch(actual interface{}) string {
+	return mismatch(m.Description(), actual)
+}
@@ -5,7 +5,6 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("stringContaining", func() {
@@ -43,4 +42,16 @@ var _ = Describe("stringContaining", func() {
 		Entry("when actual is not a string", "hi", 12),
 		Entry("when the substring does not exist in actual", "hello", "screams"),
 	)
+
+	Describe("Description", func() {
+		It("describes the values the matcher matches", func() {
+			Expect(StringContaining("users").(Describer).Description()).To(Equal(`string containing "users"`))
+		})
+	})
+
+	Describe("ExplainMismatch", func() {
+		It("explains that the value is not the described value", func() {
+			Expect(StringContaining("users").(Describer).ExplainMismatch("/v1/accounts")).To(Equal(`expected string containing "users" but got "/v1/accounts"`))
+		})
+	})
 })
@@ -0,0 +1,110 @@
+package match
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+)
+
+// StringMatching returns a new matcher that will match strings matching the
+// provided regular expression. A pattern that does not compile never matches
+// and fails the test when the matcher is given to mocka.
+func StringMatching(pattern string) SupportedKindsMatcher {
+	re, err := regexp.Compile(pattern)
+	return &stringMatching{pattern: pattern, re: re, err: err}
+}
+
+// StringMatchingRegexp returns a new matcher that will match strings matching
+// the provided compiled regular expression
+func StringMatchingRegexp(re *regexp.Regexp) SupportedKindsMatcher {
+	if re == nil {
+		return &stringMatching{err: errors.New("regular expression is nil")}
+	}
+
+	return &stringMatching{pattern: re.String(), re: re}
+}
+
+type stringMatching struct {
+	pattern string
+	re      *regexp.Regexp
+	err     error
+}
+
+// SupportedKinds returns all the kinds the string matching matcher supports.
+// Values of other kinds that implement fmt.Stringer are supported through
+// SupportsType.
+func (stringMatching) SupportedKinds() map[reflect.Kind]struct{} {
+	return map[reflect.Kind]struct{}{
+		reflect.Interface: {},
+		reflect.Slice:     {},
+		reflect.String:    {},
+	}
+}
+
+// SupportsType returns true for strings, byte slices, interfaces and every
+// type that implements fmt.Stringer, so the matcher is rejected up front for
+// types it could never match, such as a plain int or a []string
+func (stringMatching) SupportsType(t reflect.Type) bool {
+	switch {
+	case t.Implements(stringerType):
+		return true
+	case t.Kind() == reflect.Slice:
+		return t.Elem().Kind() == reflect.Uint8
+	}
+
+	return t.Kind() == reflect.String || t.Kind() == reflect.Interface
+}
+
+// Match returns true if the string, byte slice or fmt.Stringer value matches
+// the regular expression
+func (m *stringMatching) Match(value interface{}) bool {
+	if m.err != nil {
+		return false
+	}
+
+	text, ok := toText(value)
+	return ok && m.re.MatchString(text)
+}
+
+// Validate returns the error of a pattern that does not compile
+func (m *stringMatching) Validate() error {
+	return m.err
+}
+
+// Description returns a description of the values the string matching matcher matches
+func (m *stringMatching) Description() string {
+	return fmt.Sprintf("string matching %q", m.pattern)
+}
+
+// ExplainMismatch returns why the value did not match the string matching matcher
+func (m *stringMatching) ExplainMismatch(actual interface{}) string {
+	if m.err != nil {
+		return fmt.Sprintf("expected %v but the pattern is invalid: %v", m.Description(), m.err)
+	}
+
+	return mismatch(m.Description(), actual)
+}
+
+// stringerType is the type of the fmt.Stringer interface
+var stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+
+// toText returns the text of a string, including named string types, a byte
+// slice or a fmt.Stringer. It returns false for any other value.
+func toText(value interface{}) (string, bool) {
+	v := reflect.ValueOf(value)
+	switch {
+	case v.Kind() == reflect.String:
+		return v.String(), true
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8:
+		return string(v.Bytes()), true
+	case v.Kind() == reflect.Ptr && v.IsNil():
+		return "", false
+	}
+
+	if stringer, ok := value.(fmt.Stringer); ok {
+		return stringer.String(), true
+	}
+
+	return "", false
+}
@@ -0,0 +1,148 @@
+package match
+
+import (
+	"reflect"
+	"regexp"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+)
+
+type path string
+
+type route struct {
+	method string
+	path   string
+}
+
+func (r route) String() string {
+	return r.method + " " + r.path
+}
+
+var _ = Describe("stringMatching", func() {
+	Describe("StringMatching", func() {
+		It("returns a stringMatching struct", func() {
+			actual := StringMatching("")
+
+			Expect(actual).To(BeAssignableToTypeOf(new(stringMatching)))
+		})
+
+		It("is valid for a pattern that compiles", func() {
+			Expect(StringMatching("^/api").(Validator).Validate()).To(Succeed())
+		})
+
+		It("is not valid for a pattern that does not compile", func() {
+			err := StringMatching("[").(Validator).Validate()
+
+			Expect(err.Error()).To(Equal("error parsing regexp: missing closing ]: `[`"))
+		})
+	})
+
+	Describe("StringMatchingRegexp", func() {
+		It("returns a stringMatching struct", func() {
+			actual := StringMatchingRegexp(regexp.MustCompile(""))
+
+			Expect(actual).To(BeAssignableToTypeOf(new(stringMatching)))
+		})
+
+		It("is not valid for a nil regular expression", func() {
+			err := StringMatchingRegexp(nil).(Validator).Validate()
+
+			Expect(err.Error()).To(Equal("regular expression is nil"))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns the kinds of strings, byte slices and interfaces", func() {
+			actual := StringMatching("").SupportedKinds()
+
+			Expect(actual).To(Equal(
+				map[reflect.Kind]struct{}{
+					reflect.Interface: {},
+					reflect.Slice:     {},
+					reflect.String:    {},
+				}))
+		})
+	})
+
+	DescribeTable("SupportsType returns true",
+		func(value interface{}) {
+			Expect(Supports(StringMatching(""), reflect.TypeOf(value))).To(BeTrue())
+		},
+		Entry("for a string", ""),
+		Entry("for a named string", path("")),
+		Entry("for a byte slice", []byte{}),
+		Entry("for a fmt.Stringer struct", route{}),
+		Entry("for a fmt.Stringer pointer", &route{}),
+		Entry("for a numeric fmt.Stringer", time.January),
+	)
+
+	It("supports interfaces", func() {
+		Expect(Supports(StringMatching(""), reflect.TypeOf((*interface{})(nil)).Elem())).To(BeTrue())
+	})
+
+	DescribeTable("SupportsType returns false",
+		func(value interface{}) {
+			Expect(Supports(StringMatching(""), reflect.TypeOf(value))).To(BeFalse())
+		},
+		Entry("for an int", 0),
+		Entry("for a float", 0.0),
+		Entry("for a slice of strings", []string{}),
+		Entry("for a struct that does not implement fmt.Stringer", struct{}{}),
+		Entry("for a map", map[string]string{}),
+	)
+
+	DescribeTable("Match returns true",
+		func(pattern string, actual interface{}) {
+			Expect(StringMatching(pattern).Match(actual)).To(BeTrue())
+		},
+		Entry("when the string matches the pattern", `^/api/v\d+/`, "/api/v1/users"),
+		Entry("when the named string matches the pattern", `^/api/`, path("/api/users")),
+		Entry("when the byte slice matches the pattern", `^/api/`, []byte("/api/users")),
+		Entry("when the fmt.Stringer matches the pattern", `^GET /api/`, route{"GET", "/api/users"}),
+		Entry("when the fmt.Stringer pointer matches the pattern", `^GET /api/`, &route{"GET", "/api/users"}),
+		Entry("when the numeric fmt.Stringer matches the pattern", `^Jan`, time.January),
+	)
+
+	DescribeTable("Match returns false",
+		func(pattern string, actual interface{}) {
+			Expect(StringMatching(pattern).Match(actual)).To(BeFalse())
+		},
+		Entry("when actual is nil", "", nil),
+		Entry("when actual is a nil pointer", "", (*route)(nil)),
+		Entry("when actual is not a string", "", 12),
+		Entry("when actual is a slice of other elements", "", []int{1}),
+		Entry("when the string does not match the pattern", `^/api/`, "/v1/users"),
+		Entry("when the pattern does not compile", "[", "["),
+	)
+
+	It("matches with the compiled regular expression", func() {
+		re := regexp.MustCompile(`(?i)^get`)
+
+		Expect(StringMatchingRegexp(re).Match("GET /")).To(BeTrue())
+		Expect(StringMatchingRegexp(re).Match("POST /")).To(BeFalse())
+	})
+
+	It("does not match with a nil regular expression", func() {
+		Expect(StringMatchingRegexp(nil).Match("")).To(BeFalse())
+	})
+
+	Describe("Description", func() {
+		It("describes the values the matcher matches", func() {
+			Expect(StringMatching(`^/api/v\d+`).(Describer).Description()).To(Equal(`string matching "^/api/v\\d+"`))
+		})
+	})
+
+	Describe("ExplainMismatch", func() {
+		It("explains that the value is not the described value", func() {
+			Expect(StringMatching(`^/api/`).(Describer).ExplainMismatch("/v1/users")).
+				To(Equal(`expected string matching "^/api/" but got "/v1/users"`))
+		})
+
+		It("explains that the pattern is invalid", func() {
+			Expect(StringMatching("[").(Describer).ExplainMismatch("[")).
+				To(Equal("expected string matching \"[\" but the pattern is invalid: error parsing regexp: missing closing ]: `[`"))
+		})
+	})
+})
@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 	"strings"
 )
@@ -34,3 +35,13 @@ func (m *stringPrefix) Match(value interface{}) bool {
 		return false
 	}
 }
+
+// Description returns a description of the values the string prefix matcher matches
+func (m *stringPrefix) Description() string {
+	return fmt.Sprintf("string with prefix %q", m.prefix)
+}
+
+// ExplainMismatch returns why the value did not match the string prefix matcher
+func (m *stringPrefix) ExplainMismatch(actual interface{}) string {
+	return mismatch(m.Description(), actual)
+}
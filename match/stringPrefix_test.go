@@ -5,7 +5,6 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("stringPrefix", func() {
@@ -43,4 +42,16 @@ var _ = Describe("stringPrefix", func() {
 		Entry("when actual is not a string", "hi", 12),
 		Entry("when the prefix does not exist in actual", "hello", "screams"),
 	)
+
+	Describe("Description", func() {
+		It("describes the values the matcher matches", func() {
+			Expect(StringPrefix("/api").(Describer).Description()).To(Equal(`string with prefix "/api"`))
+		})
+	})
+
+	Describe("ExplainMismatch", func() {
+		It("explains that the value is not the described value", func() {
+			Expect(StringPrefix("/api").(Describer).ExplainMismatch("/v1/users")).To(Equal(`expected string with prefix "/api" but got "/v1/users"`))
+		})
+	})
 })
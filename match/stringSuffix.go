@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 	"strings"
 )
@@ -34,3 +35,13 @@ func (m *stringSuffix) Match(value interface{}) bool {
 		return false
 	}
 }
+
+// Description returns a description of the values the string suffix matcher matches
+func (m *stringSuffix) Description() string {
+	return fmt.Sprintf("string with suffix %q", m.suffix)
+}
+
+// ExplainMismatch returns why the value did not match the string suffix matcher
+func (m *stringSuffix) ExplainMismatch(actual interface{}) string {
+	return mismatch(m.Description(), actual)
+}
@@ -5,7 +5,6 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("stringSuffix", func() {
@@ -43,4 +42,16 @@ var _ = Describe("stringSuffix", func() {
 		Entry("when actual is not a string", "hi", 12),
 		Entry("when the suffix does not exist in actual", "hello", "screams"),
 	)
+
+	Describe("Description", func() {
+		It("describes the values the matcher matches", func() {
+			Expect(StringSuffix(".json").(Describer).Description()).To(Equal(`string with suffix ".json"`))
+		})
+	})
+
+	Describe("ExplainMismatch", func() {
+		It("explains that the value is not the described value", func() {
+			Expect(StringSuffix(".json").(Describer).ExplainMismatch("users.xml")).To(Equal(`expected string with suffix ".json" but got "users.xml"`))
+		})
+	})
 })
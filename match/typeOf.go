@@ -67,3 +67,13 @@ func (m *typeOf) Match(value interface{}) bool {
 		return actualType.Name() == m.typeName
 	}
 }
+
+// Description returns a description of the values the type of matcher matches
+func (m *typeOf) Description() string {
+	return fmt.Sprintf("value of type %v", m.typeName)
+}
+
+// ExplainMismatch returns why the value did not match the type of matcher
+func (m *typeOf) ExplainMismatch(actual interface{}) string {
+	return mismatch(m.Description(), actual)
+}
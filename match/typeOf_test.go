@@ -5,7 +5,6 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("typeOf", func() {
@@ -73,4 +72,16 @@ var _ = Describe("typeOf", func() {
 		Entry("when actual is nil", "int", nil),
 		Entry("when the type names do not match", "int", "i am an int"),
 	)
+
+	Describe("Description", func() {
+		It("describes the values the matcher matches", func() {
+			Expect(TypeOf("string").(Describer).Description()).To(Equal(`value of type string`))
+		})
+	})
+
+	Describe("ExplainMismatch", func() {
+		It("explains that the value is not the described value", func() {
+			Expect(TypeOf("string").(Describer).ExplainMismatch(42)).To(Equal(`expected value of type string but got 42`))
+		})
+	})
 })
@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -45,3 +46,13 @@ func (m *uintGreaterThan) Match(value interface{}) bool {
 		return false
 	}
 }
+
+// Description returns a description of the values the uint greater than matcher matches
+func (m *uintGreaterThan) Description() string {
+	return fmt.Sprintf("uint greater than %v", m.value)
+}
+
+// ExplainMismatch returns why the value did not match the uint greater than matcher
+func (m *uintGreaterThan) ExplainMismatch(actual interface{}) string {
+	return mismatch(m.Description(), actual)
+}
@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -45,3 +46,13 @@ func (m *uintGreaterThanOrEqualTo) Match(value interface{}) bool {
 		return false
 	}
 }
+
+// Description returns a description of the values the uint greater than or equal to matcher matches
+func (m *uintGreaterThanOrEqualTo) Description() string {
+	return fmt.Sprintf("uint greater than or equal to %v", m.value)
+}
+
+// ExplainMismatch returns why the value did not match the uint greater than or equal to matcher
+func (m *uintGreaterThanOrEqualTo) ExplainMismatch(actual interface{}) string {
+	return mismatch(m.Description(), actual)
+}
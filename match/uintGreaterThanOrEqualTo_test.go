@@ -5,7 +5,6 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("uintGreaterThanOrEqualTo", func() {
@@ -60,4 +59,16 @@ var _ = Describe("uintGreaterThanOrEqualTo", func() {
 		Entry("when actual(uint64) is less than expected", uint64(8), uint64(5)),
 		Entry("when actual is not an int", uint64(10), "10"),
 	)
+
+	Describe("Description", func() {
+		It("describes the values the matcher matches", func() {
+			Expect(UintGreaterThanOrEqualTo(2).(Describer).Description()).To(Equal(`uint greater than or equal to 2`))
+		})
+	})
+
+	Describe("ExplainMismatch", func() {
+		It("explains that the value is not the described value", func() {
+			Expect(UintGreaterThanOrEqualTo(2).(Describer).ExplainMismatch(uint(1))).To(Equal(`expected uint greater than or equal to 2 but got 0x1`))
+		})
+	})
 })
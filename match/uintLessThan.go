@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -45,3 +46,13 @@ func (m *uintLessThan) Match(value interface{}) bool {
 		return false
 	}
 }
+
+// Description returns a description of the values the uint less than matcher matches
+func (m *uintLessThan) Description() string {
+	return fmt.Sprintf("uint less than %v", m.value)
+}
+
+// ExplainMismatch returns why the value did not match the uint less than matcher
+func (m *uintLessThan) ExplainMismatch(actual interface{}) string {
+	return mismatch(m.Description(), actual)
+}
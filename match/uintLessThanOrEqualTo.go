@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -45,3 +46,13 @@ func (m *uintLessThanOrEqualTo) Match(value interface{}) bool {
 		return false
 	}
 }
+
+// Description returns a description of the values the uint less than or equal to matcher matches
+func (m *uintLessThanOrEqualTo) Description() string {
+	return fmt.Sprintf("uint less than or equal to %v", m.value)
+}
+
+// ExplainMismatch returns why the value did not match the uint less than or equal to matcher
+func (m *uintLessThanOrEqualTo) ExplainMismatch(actual interface{}) string {
+	return mismatch(m.Description(), actual)
+}
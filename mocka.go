@@ -61,19 +61,92 @@ type TestReporter interface {
 	Errorf(string, ...interface{})
 }
 
+// cleanupRegisterer describes a test reporter that can register functions
+// to be called when the test completes. It is satisfied by the standard
+// library testing.T.
+type cleanupRegisterer interface {
+	Cleanup(func())
+}
+
 // Function replaces the provided function with a stubbed implementation. The
 // stub has the ability to change change the return values of the original function
 // in many different cases. The stub also provides the ability to get meta data
 // associated to any call against the original function.
+//
+// If the test reporter supports Cleanup, like testing.T, the stub is verified
+// and restored automatically when the test completes.
 func Function(testReporter TestReporter, originalFuncPtr interface{}, returnValues ...interface{}) *Stub {
-	return newStub(ensureTestReporter(testReporter, log.Fatal), originalFuncPtr, returnValues)
+	testReporter = ensureTestReporter(testReporter, log.Fatal)
+
+	stub := newStub(testReporter, originalFuncPtr, returnValues)
+	if stub != nil {
+		registerCleanup(testReporter, stub.cleanup)
+	}
+
+	return stub
+}
+
+// Spy replaces the provided function with a stub that calls through to the
+// original function. The stub captures the arguments and real return values
+// of every call without changing the behavior of the function, so no return
+// values are required. Return values can still be changed selectively, such
+// as with WithArgs or OnCall.
+//
+// If the test reporter supports Cleanup, like testing.T, the spy is verified
+// and restored automatically when the test completes.
+func Spy(testReporter TestReporter, originalFuncPtr interface{}) *Stub {
+	testReporter = ensureTestReporter(testReporter, log.Fatal)
+
+	stub := newSpy(testReporter, originalFuncPtr)
+	if stub != nil {
+		registerCleanup(testReporter, stub.cleanup)
+	}
+
+	return stub
 }
 
 // CreateSandbox returns an isolated sandbox from which functions can be stubbed. The
 // benefit you receive from using a sandbox is the ability to perform one call to Restore
 // for a collection of Stubs
+//
+// If the test reporter supports Cleanup, like testing.T, the sandbox is verified
+// and restored automatically when the test completes.
 func CreateSandbox(testReporter TestReporter) *Sandbox {
-	return &Sandbox{testReporter: ensureTestReporter(testReporter, log.Fatal)}
+	sandbox := &Sandbox{testReporter: ensureTestReporter(testReporter, log.Fatal)}
+	sandbox.verifiesCleanup = registerCleanup(sandbox.testReporter, sandbox.cleanup)
+
+	return sandbox
+}
+
+// InOrder fails the test through the test reporter if the provided calls,
+// which can be recorded by different stubs, did not happen in the order
+// they are provided.
+//
+// InOrder returns true if the calls happened in order; otherwise false.
+func InOrder(testReporter TestReporter, calls ...Call) bool {
+	testReporter = ensureTestReporter(testReporter, log.Fatal)
+
+	for index := 1; index < len(calls); index++ {
+		previous, current := calls[index-1], calls[index]
+		if current.sequence <= previous.sequence {
+			reportOutOfOrderCalls(testReporter, index-1, calls)
+			return false
+		}
+	}
+
+	return true
+}
+
+// registerCleanup registers the cleanup function to be called when the
+// test completes if the test reporter supports it. It returns true if
+// the cleanup function was registered.
+func registerCleanup(testReporter TestReporter, cleanup func()) bool {
+	registerer, ok := testReporter.(cleanupRegisterer)
+	if ok {
+		registerer.Cleanup(cleanup)
+	}
+
+	return ok
 }
 
 // ensureTestReporter returns the existing test reporter or calls exit
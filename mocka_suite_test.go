@@ -2,7 +2,9 @@ package mocka
 
 import (
 	"fmt"
+	"sync"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -25,3 +27,57 @@ type mockTestReporter struct {
 func (m *mockTestReporter) Errorf(f string, args ...interface{}) {
 	m.messages = append(m.messages, fmt.Sprintf(f, args...))
 }
+
+// mockCleanupTestReporter used to simulate a test reporter
+// that supports registering cleanup functions
+type mockCleanupTestReporter struct {
+	mockTestReporter
+	cleanups []func()
+}
+
+// Cleanup appends the cleanup function to the internal cleanups slice
+func (m *mockCleanupTestReporter) Cleanup(cleanup func()) {
+	m.cleanups = append(m.cleanups, cleanup)
+}
+
+// mockClock used to simulate a clock that
+// advances instantly when sleeping
+type mockClock struct {
+	lock   sync.Mutex
+	now    time.Time
+	sleeps []time.Duration
+}
+
+// Now returns the current time of the clock
+func (m *mockClock) Now() time.Time {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.now
+}
+
+// Sleep advances the clock by the duration and appends
+// the duration to the internal sleeps slice
+func (m *mockClock) Sleep(d time.Duration) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.now = m.now.Add(d)
+	m.sleeps = append(m.sleeps, d)
+}
+
+// mockBlockingClock used to simulate a clock whose sleeps
+// block until they are released
+type mockBlockingClock struct {
+	mockClock
+	sleeping chan struct{}
+	release  chan struct{}
+}
+
+// Sleep signals that the clock is sleeping and waits
+// to be released before advancing the clock
+func (m *mockBlockingClock) Sleep(d time.Duration) {
+	m.sleeping <- struct{}{}
+	<-m.release
+	m.mockClock.Sleep(d)
+}
@@ -3,6 +3,7 @@ package mocka
 import (
 	"errors"
 	"log"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -62,6 +63,55 @@ var _ = Describe("mocka", func() {
 			}))
 		})
 
+		It("returns a stub that returns the zero values if no out parameters are supplied", func() {
+			stub := Function(GinkgoT(), &fn)
+			defer stub.Restore()
+
+			result, err := fn("hello", 2)
+
+			Expect(result).To(BeZero())
+			Expect(err).To(BeNil())
+			Expect(callCount).To(BeZero())
+		})
+
+		It("reports the qualified type names if the out parameters are not assignable", func() {
+			type otherThing struct{}
+			var thingFn func() (*Thing, time.Duration)
+
+			stub := Function(failTestReporter, &thingFn, &otherThing{}, int32(5))
+
+			Expect(stub).To(BeNil())
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: expected return values of type (*mocka.Thing, time.Duration), but received (*mocka.otherThing, int32)",
+			}))
+		})
+
+		It("converts out parameters given as untyped constants to the out parameter types", func() {
+			var durationFn func(int64) (time.Duration, float32)
+
+			stub := Function(GinkgoT(), &durationFn, 5, 1)
+			defer stub.Restore()
+			stub.WithArgs(2).Return(10, 2.5)
+
+			duration, ratio := durationFn(1)
+			Expect(duration).To(Equal(5 * time.Nanosecond))
+			Expect(ratio).To(Equal(float32(1)))
+
+			duration, ratio = durationFn(2)
+			Expect(duration).To(Equal(10 * time.Nanosecond))
+			Expect(ratio).To(Equal(float32(2.5)))
+			Expect(stub.GetCall(1).ReturnValues()).To(Equal([]interface{}{10 * time.Nanosecond, float32(2.5)}))
+		})
+
+		It("rounds floating-point constants to a float32 out parameter like Go does", func() {
+			var ratioFn func() float32
+
+			stub := Function(GinkgoT(), &ratioFn, 0.1)
+			defer stub.Restore()
+
+			Expect(ratioFn()).To(Equal(float32(0.1)))
+		})
+
 		It("reports an error if cloneValue returns an error", func() {
 			_cloneValue = func(interface{}, interface{}) error {
 				return errors.New("Ope")
@@ -104,6 +154,130 @@ var _ = Describe("mocka", func() {
 			Expect(stub).ToNot(BeNil())
 			Expect(stub.outParameters).To(Equal([]interface{}{42, nil}))
 		})
+
+		It("registers the stub to be verified and restored when the test completes", func() {
+			cleanupTestReporter := &mockCleanupTestReporter{}
+
+			stub := Function(cleanupTestReporter, &fn, 42, nil)
+			stub.Expect().Once()
+
+			Expect(cleanupTestReporter.cleanups).To(HaveLen(1))
+
+			cleanupTestReporter.cleanups[0]()
+
+			_, _ = fn("", 0)
+			Expect(callCount).To(Equal(1))
+			Expect(cleanupTestReporter.messages).To(Equal([]string{
+				"mocka: 1 unmet expectation(s):\n\texpected func(string, int) (int, error) to be called exactly 1 time, but it was called 0 times",
+			}))
+		})
+
+		It("does not register a cleanup if the stub could not be created", func() {
+			cleanupTestReporter := &mockCleanupTestReporter{}
+
+			stub := Function(cleanupTestReporter, nil)
+
+			Expect(stub).To(BeNil())
+			Expect(cleanupTestReporter.cleanups).To(BeEmpty())
+		})
+	})
+
+	Describe("Spy", func() {
+		var (
+			callCount        int
+			fn               func(str string, num int) (int, error)
+			failTestReporter *mockTestReporter
+		)
+
+		BeforeEach(func() {
+			failTestReporter = &mockTestReporter{}
+			callCount = 0
+			fn = func(str string, num int) (int, error) {
+				callCount++
+				return len(str) + num, nil
+			}
+		})
+
+		It("reports an error if the function is nil", func() {
+			var nilFn func(str string, num int) (int, error)
+			stub := Spy(failTestReporter, &nilFn)
+
+			Expect(stub).To(BeNil())
+			Expect(nilFn).To(BeNil())
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: expected the second argument to be a pointer to a non-nil function to spy on, but the function is nil",
+			}))
+		})
+
+		It("reports an error if a non-function value is passed as the function pointer", func() {
+			num := 42
+			stub := Spy(failTestReporter, &num)
+
+			Expect(stub).To(BeNil())
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: expected the second argument to be a pointer to a function, but received a pointer to a int",
+			}))
+		})
+
+		It("returns a stub that calls through to the original function", func() {
+			stub := Spy(GinkgoT(), &fn)
+			defer stub.Restore()
+
+			result, err := fn("hello", 2)
+
+			Expect(result).To(Equal(7))
+			Expect(err).To(BeNil())
+			Expect(callCount).To(Equal(1))
+		})
+
+		It("captures the arguments and return values of the original function", func() {
+			stub := Spy(GinkgoT(), &fn)
+			defer stub.Restore()
+
+			_, _ = fn("hello", 2)
+
+			Expect(stub.CallCount()).To(Equal(1))
+			Expect(stub.GetCall(0).Arguments()).To(Equal([]interface{}{"hello", 2}))
+			Expect(stub.GetCall(0).ReturnValues()).To(Equal([]interface{}{7, nil}))
+		})
+
+		It("allows return values to be changed for a set of arguments", func() {
+			stub := Spy(GinkgoT(), &fn)
+			defer stub.Restore()
+
+			stub.WithArgs("hello", 2).Return(42, nil)
+
+			Expect(fn("hello", 2)).To(Equal(42))
+			Expect(fn("hi", 2)).To(Equal(4))
+		})
+
+		It("keeps calling through once its behavior is reset", func() {
+			stub := Spy(GinkgoT(), &fn)
+			defer stub.Restore()
+			stub.Return(42, nil)
+
+			stub.ResetBehavior()
+
+			Expect(fn("hello", 2)).To(Equal(7))
+		})
+
+		It("registers the spy to be verified and restored when the test completes", func() {
+			cleanupTestReporter := &mockCleanupTestReporter{}
+
+			stub := Spy(cleanupTestReporter, &fn)
+			stub.Expect().Never()
+			_, _ = fn("", 0)
+
+			Expect(cleanupTestReporter.cleanups).To(HaveLen(1))
+
+			cleanupTestReporter.cleanups[0]()
+
+			_, _ = fn("", 0)
+			Expect(callCount).To(Equal(2))
+			Expect(cleanupTestReporter.messages).To(Equal([]string{
+				"mocka: 1 unmet expectation(s):\n\texpected func(string, int) (int, error) to be called exactly 0 times, but it was called 1 time",
+			}))
+		})
 	})
 
 	Describe("CreateSandbox", func() {
@@ -113,6 +287,172 @@ var _ = Describe("mocka", func() {
 			Expect(s).ToNot(BeNil())
 			Expect(s.stubs).To(BeNil())
 		})
+
+		It("registers the sandbox to be verified and restored when the test completes", func() {
+			fn := func(str string) int {
+				return len(str)
+			}
+			cleanupTestReporter := &mockCleanupTestReporter{}
+
+			s := CreateSandbox(cleanupTestReporter)
+			s.Function(&fn, 20).Expect().Never()
+			_ = fn("hello")
+
+			Expect(cleanupTestReporter.cleanups).To(HaveLen(1))
+
+			cleanupTestReporter.cleanups[0]()
+
+			Expect(fn("hello")).To(Equal(5))
+			Expect(s.stubs).To(BeNil())
+			Expect(cleanupTestReporter.messages).To(Equal([]string{
+				"mocka: 1 unmet expectation(s):\n\texpected func(string) (int) to be called exactly 0 times, but it was called 1 time",
+			}))
+		})
+
+		It("verifies the stubs of a sandbox that was restored before the test completed", func() {
+			fn := func(str string) int {
+				return len(str)
+			}
+			cleanupTestReporter := &mockCleanupTestReporter{}
+
+			s := CreateSandbox(cleanupTestReporter)
+			s.Function(&fn, 20).Expect().Once()
+			s.Restore()
+
+			cleanupTestReporter.cleanups[0]()
+
+			Expect(s.restored).To(BeNil())
+			Expect(cleanupTestReporter.messages).To(Equal([]string{
+				"mocka: 1 unmet expectation(s):\n\texpected func(string) (int) to be called exactly 1 time, but it was called 0 times",
+			}))
+		})
+
+		It("does not keep restored stubs if the test reporter does not support cleanup", func() {
+			fn := func(str string) int {
+				return len(str)
+			}
+
+			s := CreateSandbox(&mockTestReporter{})
+			s.Function(&fn, 20)
+			s.Restore()
+
+			Expect(s.restored).To(BeNil())
+		})
+	})
+
+	Describe("InOrder", func() {
+		var failTestReporter *mockTestReporter
+
+		BeforeEach(func() {
+			failTestReporter = &mockTestReporter{}
+		})
+
+		It("returns true if the calls happened in the provided order", func() {
+			calls := []Call{{sequence: 1}, {sequence: 4}, {sequence: 7}}
+
+			Expect(InOrder(failTestReporter, calls...)).To(BeTrue())
+			Expect(failTestReporter.messages).To(BeEmpty())
+		})
+
+		It("returns true for less than two calls", func() {
+			Expect(InOrder(failTestReporter)).To(BeTrue())
+			Expect(InOrder(failTestReporter, Call{sequence: 3})).To(BeTrue())
+			Expect(failTestReporter.messages).To(BeEmpty())
+		})
+
+		It("reports the first pair of calls that are out of order", func() {
+			calls := []Call{
+				{args: []interface{}{"begin"}, sequence: 1},
+				{args: []interface{}{"commit"}, sequence: 5},
+				{args: []interface{}{"exec"}, sequence: 3},
+			}
+
+			Expect(InOrder(failTestReporter, calls...)).To(BeFalse())
+			Expect(failTestReporter.messages).To(ConsistOf(
+				`mocka: expected call 2 with arguments ("commit") to happen before call 3 with arguments ("exec")`,
+			))
+		})
+
+		It("reports the same call provided twice as out of order", func() {
+			call := Call{sequence: 2}
+
+			Expect(InOrder(failTestReporter, call, call)).To(BeFalse())
+			Expect(failTestReporter.messages).To(HaveLen(1))
+		})
+
+		It("orders calls across stubs", func() {
+			begin := func() {}
+			exec := func(query string) error { return nil }
+			beginStub := Function(GinkgoT(), &begin)
+			defer beginStub.Restore()
+			execStub := Function(GinkgoT(), &exec, nil)
+			defer execStub.Restore()
+
+			begin()
+			_ = exec("SELECT 1")
+
+			Expect(InOrder(failTestReporter, beginStub.GetFirstCall(), execStub.GetFirstCall())).To(BeTrue())
+			Expect(InOrder(failTestReporter, execStub.GetFirstCall(), beginStub.GetFirstCall())).To(BeFalse())
+		})
+
+		It("orders calls by when they started rather than when they returned", func() {
+			slow := func() {}
+			fast := func() {}
+			clock := &mockBlockingClock{sleeping: make(chan struct{}), release: make(chan struct{})}
+			slowStub := Function(GinkgoT(), &slow)
+			defer slowStub.Restore()
+			slowStub.UseClock(clock)
+			slowStub.Delay(50 * time.Millisecond)
+			fastStub := Function(GinkgoT(), &fast)
+			defer fastStub.Restore()
+
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				slow()
+			}()
+			<-clock.sleeping
+			fast()
+			close(clock.release)
+			<-done
+
+			Expect(InOrder(failTestReporter, slowStub.GetFirstCall(), fastStub.GetFirstCall())).To(BeTrue())
+			Expect(failTestReporter.messages).To(BeEmpty())
+		})
+
+		It("orders a call before the calls it makes when calling through", func() {
+			inner := func() {}
+			outer := func() { inner() }
+			innerStub := Function(GinkgoT(), &inner)
+			defer innerStub.Restore()
+			outerStub := Spy(GinkgoT(), &outer)
+			defer outerStub.Restore()
+
+			outer()
+
+			Expect(InOrder(failTestReporter, outerStub.GetFirstCall(), innerStub.GetFirstCall())).To(BeTrue())
+			Expect(failTestReporter.messages).To(BeEmpty())
+		})
+	})
+
+	Describe("registerCleanup", func() {
+		It("registers the cleanup function if the test reporter supports it", func() {
+			called := false
+			cleanupTestReporter := &mockCleanupTestReporter{}
+
+			registered := registerCleanup(cleanupTestReporter, func() { called = true })
+			cleanupTestReporter.cleanups[0]()
+
+			Expect(registered).To(BeTrue())
+			Expect(called).To(BeTrue())
+		})
+
+		It("does nothing if the test reporter does not support cleanup", func() {
+			var registered bool
+
+			Expect(func() { registered = registerCleanup(&mockTestReporter{}, func() {}) }).ToNot(Panic())
+			Expect(registered).To(BeFalse())
+		})
 	})
 
 	Describe("ensureTestReporter", func() {
@@ -1,10 +1,13 @@
 package mocka
 
+import "time"
+
 // OnCall describes the functionality to set custom return value based on call index
 type OnCall struct {
 	stub  *Stub
 	index int
 	out   []interface{}
+	behavior
 }
 
 // Return sets the return values for this set of custom arguments
@@ -18,4 +21,130 @@ func (c *OnCall) Return(returnValues ...interface{}) {
 	}
 
 	c.out = returnValues
+	c.clearReturns()
+}
+
+// ReturnError makes the stub return the error as its trailing error result and
+// the zero values for every other out parameter for this call index
+func (c *OnCall) ReturnError(err error) {
+	c.stub.lock.Lock()
+	defer c.stub.lock.Unlock()
+
+	out, ok := zeroValuesWithError(c.stub.toType(), err)
+	if !ok {
+		reportMissingErrorResult(c.stub.testReporter, c.stub.toType())
+		return
+	}
+
+	c.out = out
+	c.clearReturns()
+}
+
+// ReturnFunc assigns a function that computes the out parameters
+// for this call index from the arguments of each call
+func (c *OnCall) ReturnFunc(returnFunc func(arguments []interface{}) []interface{}) {
+	c.stub.lock.Lock()
+	defer c.stub.lock.Unlock()
+
+	c.out = nil
+	c.clearReturns()
+	c.returnFunc = returnFunc
+}
+
+// Panics makes the stub panic with the provided value
+// for this call index
+func (c *OnCall) Panics(value interface{}) {
+	c.stub.lock.Lock()
+	defer c.stub.lock.Unlock()
+
+	c.out = nil
+	c.clearReturns()
+	c.panics = true
+	c.panicValue = value
+}
+
+// CallThrough makes the stub call the original function for this call index
+func (c *OnCall) CallThrough() {
+	c.stub.lock.Lock()
+	defer c.stub.lock.Unlock()
+
+	if !c.stub.validateCallThrough() {
+		return
+	}
+
+	c.out = nil
+	c.clearReturns()
+	c.callThrough = true
+}
+
+// Delay makes the stub wait for the duration before returning
+// for this call index. The wait goes through the stub's clock.
+func (c *OnCall) Delay(d time.Duration) {
+	c.DelayBetween(d, d)
+}
+
+// DelayBetween makes the stub wait for a random duration between least
+// and most, inclusive, before returning for this call index.
+func (c *OnCall) DelayBetween(least, most time.Duration) {
+	c.stub.lock.Lock()
+	defer c.stub.lock.Unlock()
+
+	if validateDelay(c.stub.testReporter, least, most) {
+		c.setDelay(least, most)
+	}
+}
+
+// SetArg makes the stub write the value through the pointer argument at the
+// index before returning for this call index. Indexes at or after a
+// variadic argument address its elements.
+func (c *OnCall) SetArg(index int, value interface{}) {
+	c.stub.lock.Lock()
+	defer c.stub.lock.Unlock()
+
+	av := argumentValue{index: index, value: value}
+	if validateArgumentValue(c.stub.testReporter, c.stub.toType(), av) {
+		c.setArg(av)
+	}
+}
+
+// CallsArg makes the stub call the function argument at the index with the
+// provided arguments before returning for this call index. Calling CallsArg
+// again adds another call that is made after the previous ones. The return
+// values of the function argument are captured by the call.
+func (c *OnCall) CallsArg(index int, args ...interface{}) {
+	c.addArgumentCall(argumentCall{index: index, args: args})
+}
+
+// CallsArgAsync makes the stub call the function argument at the index with the
+// provided arguments on a new goroutine for this call index, so the stub can
+// return before the function argument completes.
+func (c *OnCall) CallsArgAsync(index int, args ...interface{}) {
+	c.addArgumentCall(argumentCall{index: index, args: args, async: true})
+}
+
+// addArgumentCall validates the function argument call before adding it
+func (c *OnCall) addArgumentCall(ac argumentCall) {
+	c.stub.lock.Lock()
+	defer c.stub.lock.Unlock()
+
+	if validateArgumentCall(c.stub.testReporter, c.stub.toType(), ac) {
+		c.callArg(ac)
+	}
+}
+
+// layer returns the layer describing the behavior for this call index
+func (c *OnCall) layer() layer {
+	return layer{out: c.out, behavior: &c.behavior}
+}
+
+// findOnCall returns the OnCall for the provided call index if found;
+// otherwise a nil
+func findOnCall(onCalls []*OnCall, index int) *OnCall {
+	for _, o := range onCalls {
+		if o != nil && o.index == index {
+			return o
+		}
+	}
+
+	return nil
 }
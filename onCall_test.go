@@ -1,6 +1,9 @@
 package mocka
 
 import (
+	"errors"
+	"time"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -52,4 +55,186 @@ var _ = Describe("OnCall", func() {
 			Expect(ca.out).To(Equal([]interface{}{42, nil}))
 		})
 	})
+
+	Describe("ReturnError", func() {
+		It("returns the error with the zero values for the other out parameters", func() {
+			o := &OnCall{stub: stub}
+			o.Panics("Ope")
+
+			o.ReturnError(errors.New("Ope"))
+
+			Expect(o.out).To(Equal([]interface{}{0, errors.New("Ope")}))
+			Expect(o.panics).To(BeFalse())
+		})
+
+		It("reports an error if the function does not return an error as its last out parameter", func() {
+			var count func() int
+			stub.functionPtr = &count
+			stub.testReporter = failTestReporter
+			o := &OnCall{stub: stub}
+
+			o.ReturnError(errors.New("Ope"))
+
+			Expect(o.out).To(BeNil())
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: expected func() (int) to have an error as its last return value to use ReturnError",
+			}))
+		})
+	})
+
+	Describe("ReturnFunc", func() {
+		It("clears the out parameters and assigns the return function", func() {
+			o := &OnCall{stub: stub, index: 0, out: []interface{}{42, nil}}
+
+			o.ReturnFunc(func([]interface{}) []interface{} {
+				return []interface{}{1, nil}
+			})
+
+			Expect(o.out).To(BeNil())
+			Expect(o.returnFunc(nil)).To(Equal([]interface{}{1, nil}))
+		})
+	})
+
+	Describe("Panics", func() {
+		It("clears the out parameters and panics with the provided value", func() {
+			o := &OnCall{stub: stub, index: 0, out: []interface{}{42, nil}}
+
+			o.Panics("Ope")
+
+			Expect(o.out).To(BeNil())
+			Expect(o.panics).To(BeTrue())
+			Expect(o.panicValue).To(Equal("Ope"))
+		})
+	})
+
+	Describe("Delay", func() {
+		It("delays the call by the duration", func() {
+			o := &OnCall{stub: stub, index: 0}
+
+			o.Delay(time.Second)
+
+			Expect(o.delayed).To(BeTrue())
+			Expect(o.minDelay).To(Equal(time.Second))
+			Expect(o.maxDelay).To(Equal(time.Second))
+		})
+	})
+
+	Describe("DelayBetween", func() {
+		It("delays the call by a duration between the minimum and maximum", func() {
+			o := &OnCall{stub: stub, index: 0}
+
+			o.DelayBetween(time.Millisecond, time.Second)
+
+			Expect(o.minDelay).To(Equal(time.Millisecond))
+			Expect(o.maxDelay).To(Equal(time.Second))
+		})
+
+		It("reports an invalid delay", func() {
+			stub.testReporter = failTestReporter
+			o := &OnCall{stub: stub, index: 0}
+
+			o.DelayBetween(time.Second, time.Millisecond)
+
+			Expect(o.delayed).To(BeFalse())
+			Expect(failTestReporter.messages).To(HaveLen(1))
+		})
+	})
+
+	Describe("SetArg", func() {
+		It("reports the value because the argument is not a pointer", func() {
+			stub.testReporter = failTestReporter
+			o := &OnCall{stub: stub, index: 0}
+
+			o.SetArg(1, 42)
+
+			Expect(o.setArgs).To(BeEmpty())
+			Expect(failTestReporter.messages).To(ConsistOf("mocka: expected argument 1 to be a pointer to set its value, but it is of type int"))
+		})
+
+		It("sets the value for a pointer argument", func() {
+			fn := func(str string, n *int) (int, error) {
+				return 0, nil
+			}
+			stub.functionPtr = &fn
+			o := &OnCall{stub: stub, index: 0}
+
+			o.SetArg(1, 42)
+
+			Expect(o.setArgs).To(Equal([]argumentValue{{index: 1, value: 42}}))
+		})
+	})
+
+	Describe("CallsArg", func() {
+		It("reports the function argument call because the argument is not a function", func() {
+			stub.testReporter = failTestReporter
+			o := &OnCall{stub: stub, index: 0}
+
+			o.CallsArg(0, "hello")
+
+			Expect(o.callsArgs).To(BeEmpty())
+			Expect(failTestReporter.messages).To(ConsistOf("mocka: expected argument 0 to be a function to call it, but it is of type string"))
+		})
+
+		It("adds synchronous and asynchronous function argument calls", func() {
+			fn := func(str string, callback func(int)) (int, error) {
+				return 0, nil
+			}
+			stub.functionPtr = &fn
+			o := &OnCall{stub: stub, index: 0}
+
+			o.CallsArg(1, 1)
+			o.CallsArgAsync(1, 2)
+
+			Expect(o.callsArgs).To(Equal([]argumentCall{{index: 1, args: []interface{}{1}}, {index: 1, args: []interface{}{2}, async: true}}))
+		})
+	})
+
+	Describe("CallThrough", func() {
+		BeforeEach(func() {
+			stub.originalFunc = fn
+		})
+
+		It("clears the out parameters and calls through to the original function", func() {
+			o := &OnCall{stub: stub, index: 0, out: []interface{}{42, nil}}
+
+			o.CallThrough()
+
+			Expect(o.out).To(BeNil())
+			Expect(o.callThrough).To(BeTrue())
+		})
+
+		It("is undone by setting the return values", func() {
+			o := &OnCall{stub: stub, index: 0}
+			o.CallThrough()
+
+			o.Return(42, nil)
+
+			Expect(o.callThrough).To(BeFalse())
+		})
+
+		It("reports an error if the original function is nil", func() {
+			stub.originalFunc = nil
+			stub.testReporter = failTestReporter
+			o := &OnCall{stub: stub, index: 0}
+
+			o.CallThrough()
+
+			Expect(o.callThrough).To(BeFalse())
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: could not call through to the original function, the function is nil",
+			}))
+		})
+	})
+
+	Describe("findOnCall", func() {
+		It("returns the OnCall for the call index", func() {
+			expected := &OnCall{stub: stub, index: 1}
+
+			Expect(findOnCall([]*OnCall{nil, {stub: stub, index: 0}, expected}, 1)).To(Equal(expected))
+		})
+
+		It("returns nil if no OnCall exists for the call index", func() {
+			Expect(findOnCall([]*OnCall{{stub: stub, index: 0}}, 1)).To(BeNil())
+		})
+	})
 })
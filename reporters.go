@@ -4,21 +4,77 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
+
+	"github.com/MonsantoCo/mocka/v2/match"
 )
 
-// reportInvalidArguments reports invalid agument to fail the test
+// reportInvalidArguments reports invalid agument to fail the test. Matchers
+// are described by what they match, along with the first argument whose kind
+// a matcher does not support.
 func reportInvalidArguments(testReporter TestReporter, functionType reflect.Type, arguments []interface{}) {
-	real := make([]string, functionType.NumIn())
+	expected, received := distinctTypeNames(argumentTypes(functionType), arguments)
+	for i, argument := range arguments {
+		if matcher, ok := argument.(match.SupportedKindsMatcher); ok {
+			received[i] = match.DescriptionOf(matcher)
+		}
+	}
+
+	message := fmt.Sprintf("mocka: expected arguments of type (%v), but received (%v)", strings.Join(markVariadic(functionType, expected), ", "), strings.Join(received, ", "))
+	testReporter.Errorf("%v", withMismatch(message, explainUnsupportedMatcher(functionType, arguments)))
+}
+
+// explainUnsupportedMatcher returns which argument is a matcher that does not
+// support the kind of the argument, or an empty string if there is none
+func explainUnsupportedMatcher(functionType reflect.Type, arguments []interface{}) string {
+	for i, argument := range arguments {
+		matcher, isMatcher := argument.(match.SupportedKindsMatcher)
+		t, ok := argumentType(functionType, i)
+		if !isMatcher || !ok {
+			continue
+		}
+
+		if !match.Supports(matcher, t) {
+			return fmt.Sprintf("argument %v: %v does not support %v", i, match.DescriptionOf(matcher), toFriendlyName(t))
+		}
+	}
+
+	return ""
+}
+
+// argumentTypeNames returns the friendly names of the function's argument
+// types using ... to denote the variadic argument
+func argumentTypeNames(functionType reflect.Type) []string {
+	types := argumentTypes(functionType)
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = toFriendlyName(t)
+	}
+
+	return markVariadic(functionType, names)
+}
+
+// argumentTypes returns the types of the function's arguments, using the
+// element type for the variadic argument
+func argumentTypes(functionType reflect.Type) []reflect.Type {
+	types := make([]reflect.Type, functionType.NumIn())
 	for i := 0; i < functionType.NumIn(); i++ {
+		types[i] = functionType.In(i)
 		if isVariadicArgument(functionType, i) {
-			real[i] = fmt.Sprintf("...%v", toFriendlyName(functionType.In(i).Elem()))
-			continue
+			types[i] = types[i].Elem()
 		}
+	}
+
+	return types
+}
 
-		real[i] = toFriendlyName(functionType.In(i))
+// markVariadic prefixes the name of the function's variadic argument with ...
+func markVariadic(functionType reflect.Type, names []string) []string {
+	if last := len(names) - 1; last >= 0 && isVariadicArgument(functionType, last) {
+		names[last] = "..." + names[last]
 	}
 
-	testReporter.Errorf("mocka: expected arguments of type (%v), but received (%v)", strings.Join(real, ", "), strings.Join(mapToTypeName(arguments), ", "))
+	return names
 }
 
 // reportInvalidOutParameters reports invalid out parameters to fail the test
@@ -28,10 +84,132 @@ func reportInvalidOutParameters(testReporter TestReporter, functionType reflect.
 		return
 	}
 
-	real := make([]string, functionType.NumOut())
+	types := make([]reflect.Type, functionType.NumOut())
 	for i := 0; i < functionType.NumOut(); i++ {
-		real[i] = toFriendlyName(functionType.Out(i))
+		types[i] = functionType.Out(i)
+	}
+
+	expected, received := distinctTypeNames(types, outParameters)
+	testReporter.Errorf("mocka: expected return values of type (%v), but received (%v)", strings.Join(expected, ", "), strings.Join(received, ", "))
+}
+
+// reportMissingErrorResult reports that the function does not end in an
+// error result to return an error from to fail the test
+func reportMissingErrorResult(testReporter TestReporter, functionType reflect.Type) {
+	testReporter.Errorf("mocka: expected %v to have an error as its last return value to use ReturnError", strings.TrimSuffix(toFriendlyName(functionType), " {}"))
+}
+
+// reportUnexpectedArguments reports a call to a strict stub whose arguments did
+// not match any of the configured sets of custom arguments, along with why
+// they did not match each set, to fail the test
+func reportUnexpectedArguments(testReporter TestReporter, arguments []interface{}, customArgs []*CustomArguments) {
+	expected := make([]string, 0, len(customArgs))
+	for _, ca := range customArgs {
+		if ca != nil {
+			expected = append(expected, withMismatch(fmt.Sprintf("(%v)", strings.Join(mapToDescriptions(ca.arguments), ", ")), explainArguments(ca.argMatchers, arguments)))
+		}
+	}
+
+	if len(expected) == 0 {
+		testReporter.Errorf("mocka: unexpected call with arguments (%v), no arguments were configured", strings.Join(mapToDescriptions(arguments), ", "))
+		return
+	}
+
+	testReporter.Errorf("mocka: unexpected call with arguments (%v), expected one of:\n\t%v", strings.Join(mapToDescriptions(arguments), ", "), strings.Join(expected, "\n\t"))
+}
+
+// reportOutOfOrderCalls reports that the call at the index did not happen
+// before the call following it to fail the test
+func reportOutOfOrderCalls(testReporter TestReporter, index int, calls []Call) {
+	testReporter.Errorf(
+		"mocka: expected call %v with arguments (%v) to happen before call %v with arguments (%v)",
+		index+1,
+		strings.Join(mapToDescriptions(calls[index].args), ", "),
+		index+2,
+		strings.Join(mapToDescriptions(calls[index+1].args), ", "),
+	)
+}
+
+// reportUnmatchedCalls reports the arguments of every call, along with why
+// the calls that did not match did not match, when the calls did not meet an
+// assertion on their arguments to fail the test
+func reportUnmatchedCalls(testReporter TestReporter, expectation string, matches callMatches) {
+	if len(matches.calls) == 0 {
+		testReporter.Errorf("mocka: expected %v, but it was never called", expectation)
+		return
+	}
+
+	actual := make([]string, len(matches.calls))
+	for i, call := range matches.calls {
+		actual[i] = fmt.Sprintf("(%v)", strings.Join(mapToDescriptions(call.args), ", "))
+		if i < len(matches.mismatches) {
+			actual[i] = withMismatch(actual[i], matches.mismatches[i])
+		}
+	}
+
+	testReporter.Errorf("mocka: expected %v, but it was called with:\n\t%v", expectation, strings.Join(actual, "\n\t"))
+}
+
+// withMismatch appends the explanation of a mismatch to the arguments
+func withMismatch(arguments, mismatch string) string {
+	if mismatch == "" {
+		return arguments
+	}
+
+	return arguments + ": " + mismatch
+}
+
+// reportPredicatePanic reports a panic from inside the predicate of a matcher
+// to fail the test. Other panics from inside matchers, such as for a value of
+// a kind the matcher does not support, are not reported.
+func reportPredicatePanic(testReporter TestReporter, r interface{}) {
+	if p, ok := r.(*match.PredicatePanic); ok && testReporter != nil {
+		testReporter.Errorf("mocka: %v", p)
+	}
+}
+
+// validateDelay reports a negative delay or a minimum delay that exceeds
+// the maximum delay to fail the test
+func validateDelay(testReporter TestReporter, least, most time.Duration) bool {
+	switch {
+	case least < 0:
+		testReporter.Errorf("mocka: expected a delay of at least 0s, but received %v", least)
+		return false
+	case most < least:
+		testReporter.Errorf("mocka: expected the minimum delay of %v to not exceed the maximum delay of %v", least, most)
+		return false
+	}
+
+	return true
+}
+
+// validateMatchers reports the first argument that is a matcher that is not
+// valid, such as a pattern that does not compile, to fail the test
+func validateMatchers(testReporter TestReporter, arguments []interface{}) bool {
+	for i, arg := range arguments {
+		validator, ok := arg.(match.Validator)
+		if !ok {
+			continue
+		}
+
+		if err := validator.Validate(); err != nil {
+			testReporter.Errorf("mocka: invalid matcher for argument %v: %v", i, err)
+			return false
+		}
+	}
+
+	return true
+}
+
+// validateSequence reports the first set of out parameters of a
+// sequence that does not match the function return values to fail the test
+func validateSequence(testReporter TestReporter, functionType reflect.Type, values [][]interface{}) bool {
+	for _, outParameters := range values {
+		if !validateOutParameters(functionType, outParameters) {
+			reportInvalidOutParameters(testReporter, functionType, outParameters)
+			return false
+		}
 	}
 
-	testReporter.Errorf("mocka: expected return values of type (%v), but received (%v)", strings.Join(real, ", "), strings.Join(mapToTypeName(outParameters), ", "))
+	return true
 }
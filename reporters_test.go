@@ -1,8 +1,12 @@
 package mocka
 
 import (
+	htmltemplate "html/template"
 	"reflect"
+	"text/template"
+	"time"
 
+	"github.com/MonsantoCo/mocka/v2/match"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -44,6 +48,38 @@ var _ = Describe("reporters", func() {
 			Expect(reporter.messages).To(HaveLen(1))
 			Expect(reporter.messages).To(ContainElement("mocka: expected arguments of type (string, ...string), but received (int, int)"))
 		})
+
+		It("reports the descriptions of matchers and the argument whose kind a matcher does not support", func() {
+			arguments := []interface{}{match.StringPrefix("/api"), match.LengthOf(3)}
+
+			reportInvalidArguments(reporter, functionType, arguments)
+
+			Expect(reporter.messages).To(Equal([]string{
+				`mocka: expected arguments of type (string, int), but received (string with prefix "/api", value with length 3): argument 1: value with length 3 does not support int`,
+			}))
+		})
+
+		It("reports the variadic argument whose kind a matcher does not support", func() {
+			var fn = func(str string, opts ...int) int {
+				return len(str) + len(opts)
+			}
+
+			reportInvalidArguments(reporter, reflect.TypeOf(fn), []interface{}{"", 1, match.StringPrefix("-")})
+
+			Expect(reporter.messages).To(Equal([]string{
+				`mocka: expected arguments of type (string, ...int), but received (string, int, string with prefix "-"): argument 2: string with prefix "-" does not support int`,
+			}))
+		})
+	})
+
+	Describe("reportMissingErrorResult", func() {
+		It("reports that the function has no error as its last return value", func() {
+			reportMissingErrorResult(reporter, reflect.TypeOf(func() int { return 0 }))
+
+			Expect(reporter.messages).To(Equal([]string{
+				"mocka: expected func() (int) to have an error as its last return value to use ReturnError",
+			}))
+		})
 	})
 
 	Describe("reportInvalidOutParameters", func() {
@@ -64,5 +100,152 @@ var _ = Describe("reporters", func() {
 			Expect(reporter.messages).To(HaveLen(1))
 			Expect(reporter.messages).To(ContainElement("mocka: expected return values of type (int, error), but received (int, string)"))
 		})
+
+		It("qualifies the type names by their import path if different types share a name", func() {
+			fn := func() *template.Template { return nil }
+
+			reportInvalidOutParameters(reporter, reflect.TypeOf(fn), []interface{}{&htmltemplate.Template{}})
+
+			Expect(reporter.messages).To(Equal([]string{
+				"mocka: expected return values of type (*text/template.Template), but received (*html/template.Template)",
+			}))
+		})
+	})
+
+	Describe("reportUnexpectedArguments", func() {
+		It("reports the actual arguments and every configured set of arguments", func() {
+			customArgs := []*CustomArguments{
+				{arguments: []interface{}{"apple", 0}},
+				nil,
+				{arguments: []interface{}{"banana", 1}},
+			}
+
+			reportUnexpectedArguments(reporter, []interface{}{"cherry", 2}, customArgs)
+
+			Expect(reporter.messages).To(ConsistOf("mocka: unexpected call with arguments (\"cherry\", 2), expected one of:\n\t(\"apple\", 0)\n\t(\"banana\", 1)"))
+		})
+
+		It("reports why the arguments did not match the configured sets of arguments", func() {
+			customArgs := []*CustomArguments{
+				{arguments: []interface{}{match.StringPrefix("/api")}, argMatchers: []match.SupportedKindsMatcher{match.StringPrefix("/api")}},
+			}
+
+			reportUnexpectedArguments(reporter, []interface{}{"/v1/users"}, customArgs)
+
+			Expect(reporter.messages).To(ConsistOf("mocka: unexpected call with arguments (\"/v1/users\"), expected one of:\n\t(string with prefix \"/api\"): argument 0: expected string with prefix \"/api\" but got \"/v1/users\""))
+		})
+
+		It("reports that no arguments were configured", func() {
+			reportUnexpectedArguments(reporter, []interface{}{"cherry", 2}, nil)
+
+			Expect(reporter.messages).To(ConsistOf("mocka: unexpected call with arguments (\"cherry\", 2), no arguments were configured"))
+		})
+	})
+
+	Describe("reportOutOfOrderCalls", func() {
+		It("reports the positions and arguments of the calls that are out of order", func() {
+			calls := []Call{{args: []interface{}{"apple", 0}}, {args: []interface{}{"banana", 1}}}
+
+			reportOutOfOrderCalls(reporter, 0, calls)
+
+			Expect(reporter.messages).To(ConsistOf(`mocka: expected call 1 with arguments ("apple", 0) to happen before call 2 with arguments ("banana", 1)`))
+		})
+	})
+
+	Describe("reportUnmatchedCalls", func() {
+		It("reports the arguments of every call", func() {
+			calls := []Call{{args: []interface{}{"hi", 1}}, {args: []interface{}{"hey", 2}}}
+
+			reportUnmatchedCalls(reporter, "func(string, int) to be called with (\"hello\", 1)", callMatches{calls: calls})
+
+			Expect(reporter.messages).To(Equal([]string{
+				"mocka: expected func(string, int) to be called with (\"hello\", 1), but it was called with:\n\t(\"hi\", 1)\n\t(\"hey\", 2)",
+			}))
+		})
+
+		It("reports why the calls did not match", func() {
+			matches := callMatches{
+				calls:      []Call{{args: []interface{}{"hello", 1}}, {args: []interface{}{"hey", 2}}},
+				mismatches: []string{"", `argument 0: expected "hello" but got "hey"`},
+			}
+
+			reportUnmatchedCalls(reporter, "func(string, int) to always be called with (\"hello\", 1)", matches)
+
+			Expect(reporter.messages).To(Equal([]string{
+				"mocka: expected func(string, int) to always be called with (\"hello\", 1), but it was called with:\n\t(\"hello\", 1)\n\t(\"hey\", 2): argument 0: expected \"hello\" but got \"hey\"",
+			}))
+		})
+
+		It("reports that the function was never called", func() {
+			reportUnmatchedCalls(reporter, "func(string, int) to be called with (\"hello\", 1)", callMatches{})
+
+			Expect(reporter.messages).To(Equal([]string{
+				"mocka: expected func(string, int) to be called with (\"hello\", 1), but it was never called",
+			}))
+		})
+	})
+
+	Describe("validateDelay", func() {
+		It("returns true for a valid delay", func() {
+			Expect(validateDelay(reporter, 0, 0)).To(BeTrue())
+			Expect(validateDelay(reporter, time.Millisecond, time.Second)).To(BeTrue())
+			Expect(reporter.messages).To(BeEmpty())
+		})
+
+		It("reports a negative delay", func() {
+			Expect(validateDelay(reporter, -time.Second, time.Second)).To(BeFalse())
+			Expect(reporter.messages).To(ConsistOf("mocka: expected a delay of at least 0s, but received -1s"))
+		})
+
+		It("reports a minimum delay that exceeds the maximum delay", func() {
+			Expect(validateDelay(reporter, time.Second, time.Millisecond)).To(BeFalse())
+			Expect(reporter.messages).To(ConsistOf("mocka: expected the minimum delay of 1s to not exceed the maximum delay of 1ms"))
+		})
+	})
+	Describe("argumentTypeNames", func() {
+		It("returns the names of the argument types using ... to denote variadic arguments", func() {
+			var fn = func(str string, opts ...string) int {
+				return len(str) + len(opts)
+			}
+
+			Expect(argumentTypeNames(reflect.TypeOf(fn))).To(Equal([]string{"string", "...string"}))
+		})
+	})
+	Describe("reportPredicatePanic", func() {
+		It("reports a panic from inside the predicate of a matcher", func() {
+			reportPredicatePanic(reporter, &match.PredicatePanic{Predicate: reflect.TypeOf(func(int) bool { return true }), Argument: 1, Value: "ope"})
+
+			Expect(reporter.messages).To(ConsistOf("mocka: predicate func(int) bool panicked with ope for 1"))
+		})
+
+		It("does not report other panics", func() {
+			reportPredicatePanic(reporter, "ope")
+
+			Expect(reporter.messages).To(BeEmpty())
+		})
+	})
+
+	Describe("validateMatchers", func() {
+		It("returns true if every matcher is valid", func() {
+			Expect(validateMatchers(reporter, []interface{}{"apple", match.StringMatching("^a")})).To(BeTrue())
+			Expect(reporter.messages).To(BeEmpty())
+		})
+
+		It("reports the first matcher that is not valid", func() {
+			Expect(validateMatchers(reporter, []interface{}{"apple", match.Not(match.StringMatching("[")), match.StringMatching("(")})).To(BeFalse())
+			Expect(reporter.messages).To(ConsistOf("mocka: invalid matcher for argument 1: error parsing regexp: missing closing ]: `[`"))
+		})
+	})
+
+	Describe("validateSequence", func() {
+		It("returns true if every set of out parameters is valid", func() {
+			Expect(validateSequence(reporter, functionType, [][]interface{}{{1, nil}, {2, nil}})).To(BeTrue())
+			Expect(reporter.messages).To(BeEmpty())
+		})
+
+		It("reports the first invalid set of out parameters", func() {
+			Expect(validateSequence(reporter, functionType, [][]interface{}{{1, nil}, {"2"}, {"3"}})).To(BeFalse())
+			Expect(reporter.messages).To(ConsistOf("mocka: expected return values of type (int, error), but received (string)"))
+		})
 	})
 })
@@ -1,6 +1,9 @@
 package mocka
 
-import "sync"
+import (
+	"sort"
+	"sync"
+)
 
 // Sandbox describes an isolated environment that functions can be stubbed.
 type Sandbox struct {
@@ -8,6 +11,11 @@ type Sandbox struct {
 
 	testReporter TestReporter
 	stubs        []*Stub
+
+	// restored holds the stubs restored before the test completed so their
+	// expectations are still verified when the cleanup runs
+	restored        []*Stub
+	verifiesCleanup bool
 }
 
 // Function replaces the provided function with a stubbed implementation. The
@@ -27,6 +35,41 @@ func (s *Sandbox) Function(originalFuncPtr interface{}, returnValues ...interfac
 	return stub
 }
 
+// Spy replaces the provided function with a stub that calls through to the
+// original function. The stub captures the arguments and real return values
+// of every call without changing the behavior of the function.
+func (s *Sandbox) Spy(originalFuncPtr interface{}) *Stub {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	stub := newSpy(s.testReporter, originalFuncPtr)
+	s.stubs = append(s.stubs, stub)
+
+	return stub
+}
+
+// Adopt adds a stub created outside of the sandbox, such as a type-safe stub
+// created with Function2x1, to the sandbox so it is restored, verified and
+// reset with the rest of the sandbox. Type-safe stubs are adopted through
+// their embedded Stub, e.g. sandbox.Adopt(typedStub.Stub). Adopting a nil
+// stub or a stub that is already in the sandbox has no effect.
+func (s *Sandbox) Adopt(stub *Stub) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if stub == nil {
+		return
+	}
+
+	for _, existing := range s.stubs {
+		if existing == stub {
+			return
+		}
+	}
+
+	s.stubs = append(s.stubs, stub)
+}
+
 // Restore restores all the function stubs that were created via this sandbox to
 // the original functionality they once held.
 func (s *Sandbox) Restore() {
@@ -39,6 +82,108 @@ func (s *Sandbox) Restore() {
 		}
 	}
 
+	if s.verifiesCleanup {
+		s.restored = append(s.restored, s.stubs...)
+	}
+
 	// clears out the slice to prevent a memory leak.
 	s.stubs = nil
 }
+
+// CallsInOrder returns the calls recorded by all stubs created via this sandbox
+// interleaved in the order they happened.
+func (s *Sandbox) CallsInOrder() []Call {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var calls []Call
+	for _, stub := range s.stubs {
+		if stub != nil {
+			calls = append(calls, stub.GetCalls()...)
+		}
+	}
+
+	sort.SliceStable(calls, func(i, j int) bool {
+		return calls[i].sequence < calls[j].sequence
+	})
+
+	return calls
+}
+
+// ResetHistory clears the calls captured by all stubs created via this sandbox
+// while keeping them in place.
+func (s *Sandbox) ResetHistory() {
+	s.each((*Stub).ResetHistory)
+}
+
+// Reset clears the calls captured by, the behavior given to and the
+// expectations of all stubs created via this sandbox while keeping them in place.
+func (s *Sandbox) Reset() {
+	s.each((*Stub).Reset)
+}
+
+// each calls the function for every stub created via this sandbox
+func (s *Sandbox) each(fn func(*Stub)) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, stub := range s.stubs {
+		if stub != nil {
+			fn(stub)
+		}
+	}
+}
+
+// Verify fails the test through the test reporter if any expectations of the
+// stubs created via this sandbox are unmet. All unmet expectations are
+// reported in a single failure.
+//
+// Verify returns true if all expectations were met; otherwise false.
+func (s *Sandbox) Verify() bool {
+	return reportUnmetExpectations(s.testReporter, s.unmetExpectations(allExpectations))
+}
+
+// unmetExpectations returns the descriptions of the unmet expectations
+// included by the filter across all stubs created via this sandbox,
+// including the stubs restored before the test completed
+func (s *Sandbox) unmetExpectations(include func(*Expectation) bool) []string {
+	var unmet []string
+	for _, stub := range s.allStubs() {
+		unmet = append(unmet, stub.unmetExpectations(include)...)
+	}
+
+	return unmet
+}
+
+// allStubs returns the stubs created via this sandbox, including the
+// stubs restored before the test completed
+func (s *Sandbox) allStubs() []*Stub {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var stubs []*Stub
+	for _, stub := range append(append([]*Stub{}, s.restored...), s.stubs...) {
+		if stub != nil {
+			stubs = append(stubs, stub)
+		}
+	}
+
+	return stubs
+}
+
+// cleanup waits for the asynchronous function argument calls, verifies the
+// expectations that have not been verified yet and restores all stubs. It is called when the test completes, which can be
+// after the sandbox was already restored.
+func (s *Sandbox) cleanup() {
+	for _, stub := range s.allStubs() {
+		stub.waitForCallbacks()
+	}
+
+	reportUnmetExpectations(s.testReporter, s.unmetExpectations((*Expectation).isPending))
+	s.Restore()
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.restored = nil
+}
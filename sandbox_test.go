@@ -140,6 +140,43 @@ var _ = Describe("sandbox", func() {
 		})
 	})
 
+	Describe("Adopt", func() {
+		It("adds a stub created outside of the sandbox once", func() {
+			stub := Function(GinkgoT(), &fn2, 20)
+
+			testSandbox.Adopt(stub)
+			testSandbox.Adopt(stub)
+			testSandbox.Adopt(nil)
+
+			Expect(testSandbox.stubs).To(Equal([]*Stub{stub}))
+			testSandbox.Restore()
+			Expect(fn2("hello")).To(Equal(len("hello")))
+		})
+	})
+
+	Describe("Spy", func() {
+		It("reports an error if passed a nil as the function pointer", func() {
+			testSandbox.testReporter = failTestReporter
+			stub := testSandbox.Spy(nil)
+
+			Expect(stub).To(BeNil())
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: expected the second argument to be a pointer to a function, but received a nil",
+			}))
+		})
+
+		It("returns a stub that calls through and adds it to the sandbox", func() {
+			stub := testSandbox.Spy(&fn2)
+
+			result := fn2("hello")
+
+			Expect(result).To(Equal(len("hello")))
+			Expect(stub.CallCount()).To(Equal(1))
+			Expect(testSandbox.stubs).To(Equal([]*Stub{stub}))
+			testSandbox.Restore()
+		})
+	})
+
 	Describe("Restore", func() {
 		BeforeEach(func() {
 			_ = testSandbox.Function(&fn1, 42, nil)
@@ -175,4 +212,127 @@ var _ = Describe("sandbox", func() {
 			Expect(testSandbox.stubs).To(HaveLen(0))
 		})
 	})
+
+	Describe("CallsInOrder", func() {
+		It("returns nil if no calls were made", func() {
+			testSandbox.Function(&fn2, 42)
+
+			Expect(testSandbox.CallsInOrder()).To(BeNil())
+			testSandbox.Restore()
+		})
+
+		It("returns the calls of all stubs interleaved in the order they happened", func() {
+			testSandbox.Function(&fn1, 42, nil)
+			testSandbox.Function(&fn2, 42)
+
+			_ = fn2("first")
+			_, _ = fn1("second", 0)
+			_ = fn2("third")
+
+			calls := testSandbox.CallsInOrder()
+
+			Expect(calls).To(HaveLen(3))
+			Expect(calls[0].Arguments()).To(Equal([]interface{}{"first"}))
+			Expect(calls[1].Arguments()).To(Equal([]interface{}{"second", 0}))
+			Expect(calls[2].Arguments()).To(Equal([]interface{}{"third"}))
+			testSandbox.Restore()
+		})
+	})
+
+	Describe("ResetHistory", func() {
+		It("clears the calls of all stubs while keeping them in place", func() {
+			stub1 := testSandbox.Function(&fn1, 42, nil)
+			stub2 := testSandbox.Function(&fn2, 42)
+			_, _ = fn1("", 0)
+			_ = fn2("")
+
+			testSandbox.ResetHistory()
+
+			Expect(stub1.CallCount()).To(BeZero())
+			Expect(stub2.CallCount()).To(BeZero())
+			Expect(fn2("")).To(Equal(42))
+			testSandbox.Restore()
+		})
+	})
+
+	Describe("Reset", func() {
+		It("clears the calls and behavior of all stubs while keeping them in place", func() {
+			stub1 := testSandbox.Function(&fn1, 42, nil)
+			stub2 := testSandbox.Function(&fn2, 42)
+			stub2.Return(10)
+			_, _ = fn1("", 0)
+
+			testSandbox.Reset()
+
+			Expect(stub1.CallCount()).To(BeZero())
+			Expect(fn2("")).To(Equal(42))
+			Expect(callCounts["fn2"]).To(BeZero())
+			testSandbox.Restore()
+		})
+	})
+
+	Describe("Verify", func() {
+		It("returns true if all expectations are met", func() {
+			testSandbox.testReporter = failTestReporter
+			stub := testSandbox.Function(&fn2, 42)
+			stub.Expect().Once()
+
+			_ = fn2("")
+
+			Expect(testSandbox.Verify()).To(BeTrue())
+			Expect(failTestReporter.messages).To(BeEmpty())
+			testSandbox.Restore()
+		})
+
+		It("reports the unmet expectations of all stubs in a single failure", func() {
+			testSandbox.testReporter = failTestReporter
+			testSandbox.Function(&fn1, 42, nil).Expect().Once()
+			testSandbox.Function(&fn2, 42).Expect().Never()
+
+			_ = fn2("")
+
+			Expect(testSandbox.Verify()).To(BeFalse())
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: 2 unmet expectation(s):\n" +
+					"\texpected func(string, int) (int, error) to be called exactly 1 time, but it was called 0 times\n" +
+					"\texpected func(string) (int) to be called exactly 0 times, but it was called 1 time",
+			}))
+			testSandbox.Restore()
+		})
+	})
+
+	Describe("cleanup", func() {
+		It("reports only the expectations that have not been verified and restores the stubs", func() {
+			testSandbox.testReporter = failTestReporter
+			stub := testSandbox.Function(&fn2, 42)
+			stub.Expect().Once()
+			_ = testSandbox.Verify()
+			stub.Expect().Never()
+			_ = fn2("")
+
+			testSandbox.cleanup()
+
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: 1 unmet expectation(s):\n\texpected func(string) (int) to be called exactly 1 time, but it was called 0 times",
+				"mocka: 1 unmet expectation(s):\n\texpected func(string) (int) to be called exactly 0 times, but it was called 1 time",
+			}))
+			Expect(testSandbox.stubs).To(BeNil())
+			Expect(fn2("")).To(Equal(0))
+			Expect(callCounts["fn2"]).To(Equal(1))
+		})
+
+		It("reports the expectations of stubs restored before the cleanup", func() {
+			testSandbox.testReporter = failTestReporter
+			testSandbox.verifiesCleanup = true
+			testSandbox.Function(&fn2, 42).Expect().Once()
+			testSandbox.Restore()
+
+			testSandbox.cleanup()
+
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: 1 unmet expectation(s):\n\texpected func(string) (int) to be called exactly 1 time, but it was called 0 times",
+			}))
+			Expect(testSandbox.restored).To(BeNil())
+		})
+	})
 })
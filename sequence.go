@@ -0,0 +1,81 @@
+package mocka
+
+// exhaustionPolicy describes what a sequence returns once its values run out
+type exhaustionPolicy int
+
+const (
+	repeatLast exhaustionPolicy = iota
+	cycle
+	fallBackToDefault
+	failWhenExhausted
+)
+
+// Sequence describes out parameters returned in order, one set per call,
+// and what is returned once they run out. By default the last set of out
+// parameters is repeated.
+type Sequence struct {
+	stub     *Stub
+	values   [][]interface{}
+	position int
+	policy   exhaustionPolicy
+}
+
+// newSequence creates a sequence of out parameters that repeats the last
+// set of out parameters once they run out
+func newSequence(stub *Stub, values [][]interface{}) *Sequence {
+	return &Sequence{stub: stub, values: values, policy: repeatLast}
+}
+
+// RepeatLast makes the sequence repeat the last set of out parameters once they run out
+func (s *Sequence) RepeatLast() {
+	s.setPolicy(repeatLast)
+}
+
+// Cycle makes the sequence start over from the first set of out parameters
+// once they run out
+func (s *Sequence) Cycle() {
+	s.setPolicy(cycle)
+}
+
+// FallBackToDefault makes the stub return the out parameters it would have
+// returned without the sequence once the sequence runs out. For custom
+// arguments this is the stub's out parameters; for the stub it is the out
+// parameters provided to Function or Return.
+func (s *Sequence) FallBackToDefault() {
+	s.setPolicy(fallBackToDefault)
+}
+
+// FailWhenExhausted makes the stub fail the test through the test reporter
+// and return zero values for every call once the sequence runs out
+func (s *Sequence) FailWhenExhausted() {
+	s.setPolicy(failWhenExhausted)
+}
+
+// setPolicy sets what the sequence returns once its values run out
+func (s *Sequence) setPolicy(policy exhaustionPolicy) {
+	s.stub.lock.Lock()
+	defer s.stub.lock.Unlock()
+
+	s.policy = policy
+}
+
+// next returns the out parameters for the next call and advances the sequence.
+// It returns false if the sequence ran out and falls back to the default.
+func (s *Sequence) next() ([]interface{}, bool) {
+	index := s.position
+	s.position++
+
+	switch {
+	case index < len(s.values):
+		return s.values[index], true
+	case len(s.values) == 0 || s.policy == fallBackToDefault:
+		return nil, false
+	case s.policy == cycle:
+		return s.values[index%len(s.values)], true
+	case s.policy == failWhenExhausted:
+		s.stub.testReporter.Errorf("mocka: expected at most %v call(s) returning values in order, but the stub was called %v times", len(s.values), index+1)
+		return zeroValues(s.stub.toType()), true
+	}
+
+	return s.values[len(s.values)-1], true
+}
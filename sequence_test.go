@@ -0,0 +1,100 @@
+package mocka
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("sequence", func() {
+	var (
+		fn               func(string) (int, error)
+		stub             *Stub
+		failTestReporter *mockTestReporter
+		sequence         *Sequence
+	)
+
+	BeforeEach(func() {
+		fn = func(str string) (int, error) {
+			return len(str), nil
+		}
+		failTestReporter = &mockTestReporter{}
+		stub = &Stub{testReporter: failTestReporter, functionPtr: &fn}
+		sequence = newSequence(stub, [][]interface{}{{1, nil}, {2, nil}, {0, errors.New("Ope")}})
+	})
+
+	nextValues := func(count int) [][]interface{} {
+		var values [][]interface{}
+		for i := 0; i < count; i++ {
+			out, ok := sequence.next()
+			if !ok {
+				out = []interface{}{"default"}
+			}
+			values = append(values, out)
+		}
+		return values
+	}
+
+	Describe("newSequence", func() {
+		It("repeats the last set of out parameters by default", func() {
+			Expect(sequence.policy).To(Equal(repeatLast))
+			Expect(sequence.position).To(BeZero())
+		})
+	})
+
+	Describe("RepeatLast", func() {
+		It("repeats the last set of out parameters once they run out", func() {
+			sequence.Cycle()
+
+			sequence.RepeatLast()
+
+			Expect(nextValues(5)).To(Equal([][]interface{}{
+				{1, nil}, {2, nil}, {0, errors.New("Ope")}, {0, errors.New("Ope")}, {0, errors.New("Ope")},
+			}))
+		})
+	})
+
+	Describe("Cycle", func() {
+		It("starts over from the first set of out parameters once they run out", func() {
+			sequence.Cycle()
+
+			Expect(nextValues(5)).To(Equal([][]interface{}{
+				{1, nil}, {2, nil}, {0, errors.New("Ope")}, {1, nil}, {2, nil},
+			}))
+		})
+	})
+
+	Describe("FallBackToDefault", func() {
+		It("falls back to the default once the sets of out parameters run out", func() {
+			sequence.FallBackToDefault()
+
+			Expect(nextValues(4)).To(Equal([][]interface{}{
+				{1, nil}, {2, nil}, {0, errors.New("Ope")}, {"default"},
+			}))
+		})
+	})
+
+	Describe("FailWhenExhausted", func() {
+		It("reports every call once the sets of out parameters run out and returns zero values", func() {
+			sequence.FailWhenExhausted()
+
+			Expect(nextValues(5)[3:]).To(Equal([][]interface{}{{0, nil}, {0, nil}}))
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: expected at most 3 call(s) returning values in order, but the stub was called 4 times",
+				"mocka: expected at most 3 call(s) returning values in order, but the stub was called 5 times",
+			}))
+		})
+	})
+
+	Describe("next", func() {
+		It("falls back to the default for an empty sequence", func() {
+			sequence = newSequence(stub, nil)
+			sequence.Cycle()
+
+			_, ok := sequence.next()
+
+			Expect(ok).To(BeFalse())
+		})
+	})
+})
@@ -3,6 +3,7 @@ package mocka
 import (
 	"reflect"
 	"sync"
+	"time"
 
 	"github.com/MonsantoCo/mocka/v2/match"
 )
@@ -12,35 +13,39 @@ var _cloneValue = cloneValue
 
 // Stub represents the stub for a function
 type Stub struct {
-	lock sync.RWMutex
+	lock             sync.RWMutex
+	pendingCallbacks sync.WaitGroup
 
 	testReporter  TestReporter
 	originalFunc  interface{}
 	functionPtr   interface{}
 	outParameters []interface{}
+	defaultOut    []interface{}
 	calls         []Call
+	callIndex     int
+	callsRecorded chan struct{}
 	customArgs    []*CustomArguments
 	onCalls       []*OnCall
 	execFunc      func([]interface{})
+	expectations  []*Expectation
+	restored      bool
+	strict        bool
+	captureStack  bool
+	spy           bool
+	clock         Clock
+	behavior
 }
 
 // newStub creates a stub function and overrides the implementation of the original function.
+// Without return values the stub returns the zero values of the out parameters.
 func newStub(testReporter TestReporter, originalFuncPtr interface{}, returnValues []interface{}) *Stub {
-	if originalFuncPtr == nil {
-		testReporter.Errorf("mocka: expected the second argument to be a pointer to a function, but received a nil")
+	originalFunc, ok := toFunctionValue(testReporter, originalFuncPtr)
+	if !ok {
 		return nil
 	}
 
-	originalFuncValue := reflect.ValueOf(originalFuncPtr)
-	if originalFuncValue.Kind() != reflect.Ptr {
-		testReporter.Errorf("mocka: expected the second argument to be a pointer to a function, but received a %v", originalFuncValue.Kind().String())
-		return nil
-	}
-
-	originalFunc := originalFuncValue.Elem()
-	if originalFunc.Kind() != reflect.Func {
-		testReporter.Errorf("mocka: expected the second argument to be a pointer to a function, but received a pointer to a %v", originalFunc.Kind().String())
-		return nil
+	if len(returnValues) == 0 {
+		returnValues = zeroValues(originalFunc.Type())
 	}
 
 	if !validateOutParameters(originalFunc.Type(), returnValues) {
@@ -53,6 +58,7 @@ func newStub(testReporter TestReporter, originalFuncPtr interface{}, returnValue
 		testReporter:  testReporter,
 		functionPtr:   originalFuncPtr,
 		outParameters: returnValues,
+		defaultOut:    returnValues,
 		execFunc:      func([]interface{}) {},
 	}
 
@@ -70,22 +76,348 @@ func newStub(testReporter TestReporter, originalFuncPtr interface{}, returnValue
 	return stub
 }
 
+// newSpy creates a stub that calls through to the original function, so it
+// captures every call without changing the behavior of the function. A nil
+// original function fails the test, since there is nothing to call through to.
+func newSpy(testReporter TestReporter, originalFuncPtr interface{}) *Stub {
+	originalFunc, ok := toFunctionValue(testReporter, originalFuncPtr)
+	if !ok {
+		return nil
+	}
+
+	if originalFunc.IsNil() {
+		testReporter.Errorf("mocka: expected the second argument to be a pointer to a non-nil function to spy on, but the function is nil")
+		return nil
+	}
+
+	stub := newStub(testReporter, originalFuncPtr, nil)
+	if stub != nil {
+		stub.spy = true
+		stub.callThrough = true
+	}
+
+	return stub
+}
+
+// toFunctionValue returns the function the pointer points to. Anything
+// other than a non-nil pointer to a function fails the test.
+func toFunctionValue(testReporter TestReporter, originalFuncPtr interface{}) (reflect.Value, bool) {
+	if originalFuncPtr == nil {
+		testReporter.Errorf("mocka: expected the second argument to be a pointer to a function, but received a nil")
+		return reflect.Value{}, false
+	}
+
+	originalFuncValue := reflect.ValueOf(originalFuncPtr)
+	if originalFuncValue.Kind() != reflect.Ptr {
+		testReporter.Errorf("mocka: expected the second argument to be a pointer to a function, but received a %v", originalFuncValue.Kind().String())
+		return reflect.Value{}, false
+	}
+
+	if originalFuncValue.IsNil() {
+		testReporter.Errorf("mocka: expected the second argument to be a pointer to a function, but received a nil")
+		return reflect.Value{}, false
+	}
+
+	originalFunc := originalFuncValue.Elem()
+	if originalFunc.Kind() != reflect.Func {
+		testReporter.Errorf("mocka: expected the second argument to be a pointer to a function, but received a pointer to a %v", originalFunc.Kind().String())
+		return reflect.Value{}, false
+	}
+
+	return originalFunc, true
+}
+
 // toType gets the reflection type from the mock function pointer
 func (stub *Stub) toType() reflect.Type {
 	return reflect.ValueOf(stub.functionPtr).Elem().Type()
 }
 
 // implementation defines the function that replaces the original
-// function's functionality
+// function's functionality.
+//
+// The stub is only locked to plan and record the call. Anything that runs
+// user code, like the return function, the original function and the exec
+// function, runs unlocked so it can use the stub and concurrent callers are
+// not serialized. A call whose user code panics is still recorded, marked as
+// panicked, so its call index keeps matching GetCall.
 func (stub *Stub) implementation(arguments []reflect.Value) []reflect.Value {
+	functionType := stub.toType()
+	argumentsAsInterfaces := mapToInterfaces(arguments)
+	plan := stub.planCall(argumentsAsInterfaces, functionType)
+	if plan.unexpected {
+		reportUnexpectedArguments(stub.testReporter, argumentsAsInterfaces, plan.configuredArgs)
+	}
+
+	call := newCall(argumentsAsInterfaces, plan.sequence, plan.clock.Now())
+	if plan.captureStack {
+		call.stack = currentStack()
+	}
+
+	recorded := false
+	defer func() {
+		if !recorded {
+			stub.recordPanickedCall(call, plan.customArgs, recover())
+		}
+	}()
+
+	if plan.delay > 0 {
+		plan.clock.Sleep(plan.delay)
+		call.delay = plan.clock.Now().Sub(call.timestamp)
+	}
+
+	var outParametersAsValues []reflect.Value
+	if plan.returning.panics {
+		call.panicked = true
+		call.panicValue = plan.returning.panicValue
+	} else {
+		outParametersAsValues, call.out = stub.respond(plan.returning, functionType, arguments)
+	}
+
+	stub.writeArgumentValues(functionType, arguments, plan.argumentValues)
+	call.callbacks = stub.callArguments(functionType, arguments, plan.argumentCalls)
+
+	plan.execFunc(argumentsAsInterfaces)
+
+	recorded = true
+	stub.recordCall(call, plan.customArgs)
+
+	if call.panicked {
+		panic(call.panicValue)
+	}
+
+	return outParametersAsValues
+}
+
+// writeArgumentValues writes the values through the pointer arguments of a call
+func (stub *Stub) writeArgumentValues(functionType reflect.Type, arguments []reflect.Value, values []argumentValue) {
+	if len(values) == 0 {
+		return
+	}
+
+	spread := spreadArguments(functionType, mapToInterfaces(arguments))
+	for _, av := range values {
+		writeArgumentValue(stub.testReporter, spread, av)
+	}
+}
+
+// callArguments calls the function arguments of a call and returns the holder
+// of their return values. Asynchronous calls are made in sequence on a new
+// goroutine once the synchronous calls are complete.
+func (stub *Stub) callArguments(functionType reflect.Type, arguments []reflect.Value, calls []argumentCall) *callbackResults {
+	if len(calls) == 0 {
+		return nil
+	}
+
+	results := newCallbackResults()
+	spread := spreadArguments(functionType, mapToInterfaces(arguments))
+	synchronous, asynchronous := partitionArgumentCalls(calls)
+	results.callAll(stub.testReporter, spread, synchronous)
+	if len(asynchronous) == 0 {
+		close(results.done)
+		return results
+	}
+
+	stub.pendingCallbacks.Add(1)
+	go func() {
+		defer stub.pendingCallbacks.Done()
+		results.callAllAsync(stub.testReporter, spread, asynchronous)
+	}()
+
+	return results
+}
+
+// waitForCallbacks blocks until the asynchronous function argument calls
+// made by the stub complete, so they cannot fail the test after it completed
+func (stub *Stub) waitForCallbacks() {
+	stub.pendingCallbacks.Wait()
+}
+
+// callPlan holds everything a call needs from the stub's configuration.
+// It is captured under the stub's lock so the call can proceed without it.
+type callPlan struct {
+	returning      layer
+	customArgs     *CustomArguments
+	configuredArgs []*CustomArguments
+	unexpected     bool
+	captureStack   bool
+	execFunc       func([]interface{})
+	delay          time.Duration
+	clock          Clock
+	argumentValues []argumentValue
+	argumentCalls  []argumentCall
+	sequence       uint64
+}
+
+// planCall resolves the layer that decides the return values of a call and
+// reserves the call indexes of the stub and the matched custom arguments.
+// The call takes its sequence number here, when it starts, so calls are
+// ordered by when they were made rather than when they returned.
+func (stub *Stub) planCall(arguments []interface{}, functionType reflect.Type) callPlan {
 	stub.lock.Lock()
 	defer stub.lock.Unlock()
 
-	functionType := stub.toType()
-	argumentsAsInterfaces := mapToInterfaces(arguments)
-	outParameters, maybeCustomArguments := stub.getReturnValues(argumentsAsInterfaces, functionType)
-	outParametersAsValues := mapToReflectValue(outParameters)
+	layers, maybeCustomArguments := stub.getLayers(arguments, functionType)
+	plan := callPlan{
+		returning:      layers.returning().snapshot(),
+		customArgs:     maybeCustomArguments,
+		captureStack:   stub.captureStack,
+		execFunc:       stub.execFunc,
+		delay:          layers.delay(),
+		clock:          stub.clock,
+		argumentValues: layers.argumentValues(),
+		argumentCalls:  layers.argumentCalls(),
+	}
 
+	if plan.clock == nil {
+		plan.clock = systemClock{}
+	}
+
+	if stub.strict && maybeCustomArguments == nil {
+		plan.unexpected = true
+		plan.configuredArgs = append([]*CustomArguments(nil), stub.customArgs...)
+		plan.returning = zeroValueLayers(functionType).returning()
+	}
+
+	stub.callIndex++
+	if maybeCustomArguments != nil {
+		maybeCustomArguments.callIndex++
+	}
+
+	plan.sequence = nextCallSequence()
+	return plan
+}
+
+// recordCall appends the call to the calls of the stub and wakes
+// up anyone waiting for calls
+func (stub *Stub) recordCall(call Call, maybeCustomArguments *CustomArguments) {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	stub.calls = append(stub.calls, call)
+
+	if maybeCustomArguments != nil {
+		maybeCustomArguments.callCount++
+	}
+
+	if stub.callsRecorded != nil {
+		close(stub.callsRecorded)
+		stub.callsRecorded = nil
+	}
+}
+
+// recordPanickedCall records a call whose user code did not return, marked as
+// panicked with the value, before panicking with the value again. A nil value
+// means the goroutine is exiting, like after t.FailNow, so the call is
+// recorded without panicking and the goroutine is left to exit.
+func (stub *Stub) recordPanickedCall(call Call, maybeCustomArguments *CustomArguments, value interface{}) {
+	if value == nil {
+		stub.recordCall(call, maybeCustomArguments)
+		return
+	}
+
+	call.panicked = true
+	call.panicValue = value
+	stub.recordCall(call, maybeCustomArguments)
+
+	panic(value)
+}
+
+// newCall returns the call meta data for a call with the provided arguments
+// and sequence number made from the current goroutine at the provided time
+func newCall(arguments []interface{}, sequence uint64, timestamp time.Time) Call {
+	return Call{
+		args:      arguments,
+		sequence:  sequence,
+		timestamp: timestamp,
+		caller:    callerLocation(),
+		goroutine: currentGoroutineID(),
+	}
+}
+
+// respond returns the out parameters for a call based on the
+// layer that decides the return values
+func (stub *Stub) respond(returning layer, functionType reflect.Type, arguments []reflect.Value) ([]reflect.Value, []interface{}) {
+	switch {
+	case returning.returnFunc != nil:
+		outParameters := returning.returnFunc(mapToInterfaces(arguments))
+		if !validateOutParameters(functionType, outParameters) {
+			reportInvalidOutParameters(stub.testReporter, functionType, outParameters)
+			outParameters = make([]interface{}, functionType.NumOut())
+		}
+
+		return toOutValues(functionType, outParameters)
+	case returning.callThrough:
+		outParametersAsValues := stub.callOriginal(functionType, arguments)
+		return outParametersAsValues, mapToInterfaces(outParametersAsValues)
+	default:
+		return toOutValues(functionType, returning.out)
+	}
+}
+
+// zeroValueLayers returns layers that only return the
+// zero values of the function's out parameters
+func zeroValueLayers(functionType reflect.Type) layers {
+	return layers{{out: zeroValues(functionType), behavior: &behavior{}}}
+}
+
+// zeroValues returns the zero values of the function's out parameters
+func zeroValues(functionType reflect.Type) []interface{} {
+	out := make([]interface{}, functionType.NumOut())
+	for index := range out {
+		out[index] = reflect.Zero(functionType.Out(index)).Interface()
+	}
+
+	return out
+}
+
+// errorType is the type of the error interface
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// zeroValuesWithError returns the zero values of the function's out parameters
+// with the error as the trailing error result. It returns false if the last
+// out parameter of the function is not an error.
+func zeroValuesWithError(functionType reflect.Type, err error) ([]interface{}, bool) {
+	last := functionType.NumOut() - 1
+	if last < 0 || functionType.Out(last) != errorType {
+		return nil, false
+	}
+
+	out := zeroValues(functionType)
+	out[last] = err
+	return out, true
+}
+
+// smartValues returns values of the function's out parameters that are safe
+// to use. Slices and maps are empty instead of nil and pointers point to a
+// zero value; any other type is the zero value.
+func smartValues(functionType reflect.Type) []interface{} {
+	out := make([]interface{}, functionType.NumOut())
+	for index := range out {
+		out[index] = smartValue(functionType.Out(index)).Interface()
+	}
+
+	return out
+}
+
+// smartValue returns a value of the type that is safe to use
+func smartValue(t reflect.Type) reflect.Value {
+	switch t.Kind() {
+	case reflect.Slice:
+		return reflect.MakeSlice(t, 0, 0)
+	case reflect.Map:
+		return reflect.MakeMap(t)
+	case reflect.Ptr:
+		return reflect.New(t.Elem())
+	default:
+		return reflect.Zero(t)
+	}
+}
+
+// toOutValues converts the out parameters into the reflection values returned by
+// the stubbed implementation and the interface values recorded for the call.
+// Values given as untyped constants are converted to the out parameter types.
+func toOutValues(functionType reflect.Type, outParameters []interface{}) ([]reflect.Value, []interface{}) {
+	outParametersAsValues := mapToReflectValue(convertOutParameters(functionType, outParameters))
 	outParametersAsInterfaces := make([]interface{}, len(outParametersAsValues))
 	for index, value := range outParametersAsValues {
 		outParamType := functionType.Out(index)
@@ -103,47 +435,42 @@ func (stub *Stub) implementation(arguments []reflect.Value) []reflect.Value {
 		}
 	}
 
-	stub.execFunc(argumentsAsInterfaces)
-
-	stub.calls = append(stub.calls, Call{args: argumentsAsInterfaces, out: outParametersAsInterfaces})
+	return outParametersAsValues, outParametersAsInterfaces
+}
 
-	if maybeCustomArguments != nil {
-		maybeCustomArguments.callCount++
+// callOriginal calls the original function with the provided arguments
+// and returns the real out parameters
+func (stub *Stub) callOriginal(functionType reflect.Type, arguments []reflect.Value) []reflect.Value {
+	originalFunc := reflect.ValueOf(stub.originalFunc)
+	if functionType.IsVariadic() {
+		return originalFunc.CallSlice(arguments)
 	}
 
-	return outParametersAsValues
+	return originalFunc.Call(arguments)
 }
 
-// getReturnValues returns the correct out parameters based on the
-// arguments passed into the function.
+// getLayers returns the layers that apply to a call based on the
+// arguments passed into the function, ordered from the highest
+// priority to the lowest.
 //
 // This function also takes into account the current call index of function.
-func (stub *Stub) getReturnValues(arguments []interface{}, functionType reflect.Type) ([]interface{}, *CustomArguments) {
-	out := stub.outParameters
-
-	for _, o := range stub.onCalls {
-		if o.index == len(stub.calls) && o.out != nil {
-			out = o.out
-			break
-		}
-	}
+func (stub *Stub) getLayers(arguments []interface{}, functionType reflect.Type) (layers, *CustomArguments) {
+	var ls layers
 
 	maybeCustomArgs := getHighestPriority(getPossible(stub.customArgs, arguments), functionType.NumIn())
-	if maybeCustomArgs == nil {
-		return out, nil
-	}
+	if maybeCustomArgs != nil {
+		if o := findOnCall(maybeCustomArgs.onCalls, maybeCustomArgs.callIndex); o != nil {
+			ls = append(ls, o.layer())
+		}
 
-	if maybeCustomArgs.out != nil {
-		out = maybeCustomArgs.out
+		ls = append(ls, maybeCustomArgs.layer())
 	}
 
-	for _, o := range maybeCustomArgs.onCalls {
-		if o.index == maybeCustomArgs.callCount && o.out != nil {
-			return o.out, maybeCustomArgs
-		}
+	if o := findOnCall(stub.onCalls, stub.callIndex); o != nil {
+		ls = append(ls, o.layer())
 	}
 
-	return out, maybeCustomArgs
+	return append(ls, layer{out: stub.outParameters, behavior: &stub.behavior}), maybeCustomArgs
 }
 
 // getHighestPriority returns the highest priority custom arguments if found;
@@ -205,6 +532,179 @@ func (stub *Stub) Return(returnValues ...interface{}) {
 	}
 
 	stub.outParameters = returnValues
+	stub.clearReturns()
+}
+
+// ReturnError makes the stub return the error as its trailing error result and
+// the zero values for every other out parameter. A function without a trailing
+// error result fails the test.
+func (stub *Stub) ReturnError(err error) {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	out, ok := zeroValuesWithError(stub.toType(), err)
+	if !ok {
+		reportMissingErrorResult(stub.testReporter, stub.toType())
+		return
+	}
+
+	stub.outParameters = out
+	stub.clearReturns()
+}
+
+// ReturnZero makes the stub return the zero values of the out parameters,
+// such as 0, "" and nil, when the mock function is called
+func (stub *Stub) ReturnZero() {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	stub.outParameters = zeroValues(stub.toType())
+	stub.clearReturns()
+}
+
+// ReturnSmartDefaults makes the stub return values that are safe to use
+// when the mock function is called. Slices and maps are empty instead of nil
+// and pointers point to a zero value, so the code under test does not panic
+// on them. Every call receives new values; any other type returns its zero
+// value, which keeps errors nil.
+func (stub *Stub) ReturnSmartDefaults() {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	functionType := stub.toType()
+	stub.clearReturns()
+	stub.returnFunc = func([]interface{}) []interface{} {
+		return smartValues(functionType)
+	}
+}
+
+// ReturnFunc assigns a function that computes the out parameters from the
+// arguments of each call. The computed out parameters are validated on every
+// call; invalid out parameters fail the test and zero values are returned.
+func (stub *Stub) ReturnFunc(returnFunc func(arguments []interface{}) []interface{}) {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	stub.clearReturns()
+	stub.returnFunc = returnFunc
+}
+
+// ReturnsInOrder makes the stub return the sets of out parameters in order,
+// one set per call. Custom arguments and call indexes that were given their
+// own return values still take priority over the sequence.
+//
+// The returned sequence describes what is returned once the sets of out
+// parameters run out. By default the last set is repeated.
+func (stub *Stub) ReturnsInOrder(values ...[]interface{}) *Sequence {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	sequence := newSequence(stub, values)
+	if validateSequence(stub.testReporter, stub.toType(), values) {
+		stub.clearReturns()
+		stub.sequence = sequence
+	}
+
+	return sequence
+}
+
+// Panics makes the stub panic with the provided value when called. Custom
+// arguments and call indexes that were given their own return values still
+// take priority over panicking.
+//
+// The call is captured by the stub before the panic is raised.
+func (stub *Stub) Panics(value interface{}) {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	stub.clearReturns()
+	stub.panics = true
+	stub.panicValue = value
+}
+
+// CallThrough makes the stub call the original function and return its
+// real out parameters. Custom arguments and call indexes that were given
+// their own return values still take priority over calling through.
+//
+// The call is still captured by the stub with the real out parameters.
+// A nil original function fails the test, since there is nothing to call through to.
+func (stub *Stub) CallThrough() {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	if !stub.validateCallThrough() {
+		return
+	}
+
+	stub.clearReturns()
+	stub.callThrough = true
+}
+
+// validateCallThrough reports an original function that is nil, which
+// cannot be called through, to fail the test
+func (stub *Stub) validateCallThrough() bool {
+	original := reflect.ValueOf(stub.originalFunc)
+	if original.IsValid() && !original.IsNil() {
+		return true
+	}
+
+	stub.testReporter.Errorf("mocka: could not call through to the original function, the function is nil")
+	return false
+}
+
+// Delay makes the stub wait for the duration before returning. The wait goes
+// through the stub's clock and the measured delay is recorded on the call.
+func (stub *Stub) Delay(d time.Duration) {
+	stub.DelayBetween(d, d)
+}
+
+// DelayBetween makes the stub wait for a random duration between least and
+// most, inclusive, before returning.
+func (stub *Stub) DelayBetween(least, most time.Duration) {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	if validateDelay(stub.testReporter, least, most) {
+		stub.setDelay(least, most)
+	}
+}
+
+// SetArg makes the stub write the value through the pointer argument at the
+// index before returning. Indexes at or after a variadic argument
+// address its elements. The value is validated against the argument type.
+func (stub *Stub) SetArg(index int, value interface{}) {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	av := argumentValue{index: index, value: value}
+	if validateArgumentValue(stub.testReporter, stub.toType(), av) {
+		stub.setArg(av)
+	}
+}
+
+// CallsArg makes the stub call the function argument at the index with the
+// provided arguments before returning. Calling CallsArg again adds another call
+// that is made after the previous ones. The return values of the function
+// argument are captured by the call.
+func (stub *Stub) CallsArg(index int, args ...interface{}) {
+	stub.addArgumentCall(argumentCall{index: index, args: args})
+}
+
+// CallsArgAsync makes the stub call the function argument at the index with the
+// provided arguments on a new goroutine, so the stub can return before the
+// function argument completes.
+func (stub *Stub) CallsArgAsync(index int, args ...interface{}) {
+	stub.addArgumentCall(argumentCall{index: index, args: args, async: true})
+}
+
+// addArgumentCall validates the function argument call before adding it
+func (stub *Stub) addArgumentCall(ac argumentCall) {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	if validateArgumentCall(stub.testReporter, stub.toType(), ac) {
+		stub.callArg(ac)
+	}
 }
 
 // WithArgs returns a StubWithArgs that can change the out parameters
@@ -225,6 +725,36 @@ func (stub *Stub) WithArgs(arguments ...interface{}) *CustomArguments {
 	return newCA
 }
 
+// Strict makes the stub fail the test when it is called with arguments that
+// do not match any set of custom arguments configured with WithArgs. The
+// actual arguments and every configured set are reported through the test
+// reporter and the zero values of the out parameters are returned.
+func (stub *Stub) Strict() {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	stub.strict = true
+}
+
+// CaptureStack makes the stub capture the stack trace of every call, which
+// is available through Call.Stack. Capturing the stack is opt-in because it
+// is expensive compared to the rest of the call meta data.
+func (stub *Stub) CaptureStack() {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	stub.captureStack = true
+}
+
+// UseClock replaces the clock the stub uses to timestamp and delay calls.
+// By default the stub uses the system clock.
+func (stub *Stub) UseClock(clock Clock) {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	stub.clock = clock
+}
+
 // CallCount returns the number of times the original function was called
 // after the function was stubbed
 func (stub *Stub) CallCount() int {
@@ -234,6 +764,46 @@ func (stub *Stub) CallCount() int {
 	return len(stub.calls)
 }
 
+// WaitForCalls blocks until the stub has been called at least n times or the
+// timeout elapses. It is useful to test code that calls the stub from
+// background goroutines.
+//
+// WaitForCalls returns true if the stub was called at least n times; otherwise false.
+func (stub *Stub) WaitForCalls(n int, timeout time.Duration) bool {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		recorded, done := stub.awaitCalls(n)
+		if done {
+			return true
+		}
+
+		select {
+		case <-recorded:
+		case <-timer.C:
+			return stub.CallCount() >= n
+		}
+	}
+}
+
+// awaitCalls returns true if the stub has been called at least n times;
+// otherwise a channel that is closed when the next call is recorded
+func (stub *Stub) awaitCalls(n int) (<-chan struct{}, bool) {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	if len(stub.calls) >= n {
+		return nil, true
+	}
+
+	if stub.callsRecorded == nil {
+		stub.callsRecorded = make(chan struct{})
+	}
+
+	return stub.callsRecorded, false
+}
+
 // GetCalls returns all calls made to the original function that were
 // captured by the stubbed implementation
 func (stub *Stub) GetCalls() []Call {
@@ -262,7 +832,7 @@ func (stub *Stub) GetCall(callIndex int) Call {
 	stub.lock.RLock()
 	defer stub.lock.RUnlock()
 
-	if callIndex < 0 || callIndex >= stub.CallCount() {
+	if callIndex < 0 || callIndex >= len(stub.calls) {
 		stub.testReporter.Errorf("mocka: attempted to get Call for invocation %v, when the function has only been called %v times", callIndex, len(stub.calls))
 		return Call{}
 	}
@@ -318,6 +888,170 @@ func (stub *Stub) CalledThrice() bool {
 	return stub.CallCount() >= 3
 }
 
+// CalledWith returns true if the original function was called at least once
+// with arguments matching the provided arguments. The arguments mean the same
+// as for WithArgs with one exception: variadic arguments of the call after
+// the provided ones are ignored. This also applies to AlwaysCalledWith,
+// NeverCalledWith and CalledWithAt.
+func (stub *Stub) CalledWith(arguments ...interface{}) bool {
+	return stub.checkArguments(calledWith, arguments)
+}
+
+// CalledWithExactly returns true if the original function was called at least
+// once with exactly the provided arguments, including every variadic argument.
+// The arguments mean the same as for WithArgs.
+func (stub *Stub) CalledWithExactly(arguments ...interface{}) bool {
+	return stub.checkArguments(calledWithExactly, arguments)
+}
+
+// AlwaysCalledWith returns true if the original function was called and
+// every call had arguments matching the provided arguments.
+func (stub *Stub) AlwaysCalledWith(arguments ...interface{}) bool {
+	return stub.checkArguments(alwaysCalledWith, arguments)
+}
+
+// NeverCalledWith returns true if no call to the original function had
+// arguments matching the provided arguments.
+func (stub *Stub) NeverCalledWith(arguments ...interface{}) bool {
+	return stub.checkArguments(neverCalledWith, arguments)
+}
+
+// CalledWithAt returns true if the call at the index had arguments matching
+// the provided arguments. The index starts at zero, the same as for GetCall.
+func (stub *Stub) CalledWithAt(index int, arguments ...interface{}) bool {
+	return stub.checkArguments(calledWithAt(index), arguments)
+}
+
+// AssertCalledWith behaves like CalledWith, but also fails the test through the
+// test reporter with the arguments of every call when it returns false.
+func (stub *Stub) AssertCalledWith(arguments ...interface{}) bool {
+	return stub.assertArguments(calledWith, arguments)
+}
+
+// AssertCalledWithExactly behaves like CalledWithExactly, but also fails the test
+// through the test reporter with the arguments of every call when it returns false.
+func (stub *Stub) AssertCalledWithExactly(arguments ...interface{}) bool {
+	return stub.assertArguments(calledWithExactly, arguments)
+}
+
+// AssertAlwaysCalledWith behaves like AlwaysCalledWith, but also fails the test
+// through the test reporter with the arguments of every call when it returns false.
+func (stub *Stub) AssertAlwaysCalledWith(arguments ...interface{}) bool {
+	return stub.assertArguments(alwaysCalledWith, arguments)
+}
+
+// AssertNeverCalledWith behaves like NeverCalledWith, but also fails the test
+// through the test reporter with the arguments of every call when it returns false.
+func (stub *Stub) AssertNeverCalledWith(arguments ...interface{}) bool {
+	return stub.assertArguments(neverCalledWith, arguments)
+}
+
+// AssertCalledWithAt behaves like CalledWithAt, but also fails the test through
+// the test reporter with the arguments of every call when it returns false.
+func (stub *Stub) AssertCalledWithAt(index int, arguments ...interface{}) bool {
+	return stub.assertArguments(calledWithAt(index), arguments)
+}
+
+// checkArguments returns true if the captured calls meet the assertion
+func (stub *Stub) checkArguments(assertion argumentAssertion, arguments []interface{}) bool {
+	matches, ok := stub.matchCalls(assertion, arguments)
+	return ok && assertion.isMet(matches)
+}
+
+// assertArguments returns true if the captured calls meet the assertion;
+// otherwise the captured calls are reported to fail the test
+func (stub *Stub) assertArguments(assertion argumentAssertion, arguments []interface{}) bool {
+	matches, ok := stub.matchCalls(assertion, arguments)
+	if !ok {
+		return false
+	}
+
+	if !assertion.isMet(matches) {
+		reportUnmatchedCalls(stub.testReporter, assertion.describe(stub.toType(), arguments), matches)
+		return false
+	}
+
+	return true
+}
+
+// matchCalls returns the captured calls along with whether each of them
+// matches the arguments and why it did not. Arguments that are not valid
+// for the function fail the test.
+func (stub *Stub) matchCalls(assertion argumentAssertion, arguments []interface{}) (callMatches, bool) {
+	matcher, ok := newArgumentsMatcher(stub.testReporter, stub.toType(), arguments)
+	if !ok {
+		return callMatches{}, false
+	}
+
+	calls := stub.GetCalls()
+	matched := make([]bool, len(calls))
+	mismatches := make([]string, len(calls))
+	for i, call := range calls {
+		arguments := assertion.arguments(matcher, call)
+		matched[i] = matcher.matches(arguments)
+		if !matched[i] && assertion.expectsMatches {
+			mismatches[i] = matcher.explainMismatch(arguments)
+		}
+	}
+
+	return callMatches{calls: calls, matched: matched, mismatches: mismatches}, true
+}
+
+// Expect returns an expectation for how many times the original function is
+// called. The expectation defaults to the function being called at least once
+// and is checked when Verify is called.
+func (stub *Stub) Expect() *Expectation {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	e := newExpectation(stub, nil)
+	stub.expectations = append(stub.expectations, e)
+	return e
+}
+
+// Verify fails the test through the test reporter if any expectations of the
+// stub are unmet. All unmet expectations are reported in a single failure.
+//
+// Verify returns true if all expectations were met; otherwise false.
+func (stub *Stub) Verify() bool {
+	return reportUnmetExpectations(stub.testReporter, stub.unmetExpectations(allExpectations))
+}
+
+// allExpectations includes every expectation when checking for unmet expectations
+func allExpectations(*Expectation) bool {
+	return true
+}
+
+// unmetExpectations returns the descriptions of the unmet expectations that
+// are included by the filter. Every included expectation is marked as verified.
+func (stub *Stub) unmetExpectations(include func(*Expectation) bool) []string {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	var unmet []string
+	for _, e := range stub.expectations {
+		if !include(e) {
+			continue
+		}
+
+		e.verified = true
+		if !e.isMet() {
+			unmet = append(unmet, e.String())
+		}
+	}
+
+	return unmet
+}
+
+// cleanup waits for the asynchronous function argument calls, verifies the
+// expectations that have not been verified yet and restores the original
+// function. It is called when the test completes.
+func (stub *Stub) cleanup() {
+	stub.waitForCallbacks()
+	reportUnmetExpectations(stub.testReporter, stub.unmetExpectations((*Expectation).isPending))
+	stub.Restore()
+}
+
 // OnCall returns an interface that allows for changing the
 // return values based on the call index.
 func (stub *Stub) OnCall(index int) *OnCall {
@@ -353,12 +1087,76 @@ func (stub *Stub) OnThirdCall() *OnCall {
 	return stub.OnCall(2)
 }
 
+// ResetHistory clears the calls captured by the stub while keeping it in place.
+// Call indexes, the call counts of custom arguments and sequences start over,
+// so OnCall and ReturnsInOrder apply again from the first call.
+func (stub *Stub) ResetHistory() {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	stub.calls = nil
+	stub.callIndex = 0
+	stub.rewind()
+
+	for _, ca := range stub.customArgs {
+		if ca != nil {
+			ca.callIndex = 0
+			ca.callCount = 0
+			ca.rewind()
+		}
+	}
+}
+
+// ResetBehavior removes the custom arguments, call indexes, exec function and
+// any other behavior given to the stub while keeping it in place. The stub
+// returns the out parameters it was created with again, or calls through to
+// the original function if it was created as a spy. Expectations of the removed
+// custom arguments are removed with them; captured calls and the expectations
+// of the stub itself are kept.
+func (stub *Stub) ResetBehavior() {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	stub.outParameters = stub.defaultOut
+	stub.behavior = behavior{callThrough: stub.spy}
+	stub.customArgs = nil
+	stub.onCalls = nil
+	stub.execFunc = func([]interface{}) {}
+
+	var expectations []*Expectation
+	for _, e := range stub.expectations {
+		if e.customArgs == nil {
+			expectations = append(expectations, e)
+		}
+	}
+	stub.expectations = expectations
+}
+
+// Reset clears the calls captured by the stub, the behavior given to it
+// and its expectations while keeping it in place, so every case of a
+// table driven test can declare and verify its own expectations.
+func (stub *Stub) Reset() {
+	stub.ResetBehavior()
+	stub.ResetHistory()
+
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	stub.expectations = nil
+}
+
 // Restore removes the stub and restores the the original
-// functionality back to the method
+// functionality back to the method. Calling Restore more
+// than once has no effect.
 func (stub *Stub) Restore() {
 	stub.lock.Lock()
 	defer stub.lock.Unlock()
 
+	if stub.restored {
+		return
+	}
+	stub.restored = true
+
 	valueOforiginalFunc := reflect.ValueOf(stub.originalFunc)
 	functionValue := reflect.ValueOf(stub.functionPtr).Elem()
 
@@ -3,6 +3,9 @@ package mocka
 import (
 	"errors"
 	"reflect"
+	"runtime"
+	"sync/atomic"
+	"time"
 
 	"github.com/MonsantoCo/mocka/v2/match"
 	. "github.com/onsi/ginkgo"
@@ -73,6 +76,16 @@ var _ = Describe("stub", func() {
 			}))
 		})
 
+		It("reports an error if a nil function pointer is passed as the function pointer", func() {
+			var nilFn *func()
+			stub := newStub(failTestReporter, nilFn, nil)
+
+			Expect(stub).To(BeNil())
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: expected the second argument to be a pointer to a function, but received a nil",
+			}))
+		})
+
 		It("reports an error if a non-function value is passed as the function pointer", func() {
 			num := 42
 			stub := newStub(failTestReporter, &num, nil)
@@ -133,15 +146,85 @@ var _ = Describe("stub", func() {
 			Expect(stub).ToNot(BeNil())
 			Expect(stub.outParameters).To(Equal([]interface{}{42, nil}))
 		})
+
+		It("returns a Stub with the zero values as outParameters if none are supplied", func() {
+			stub := newStub(GinkgoT(), &fn, nil)
+			defer stub.Restore()
+
+			Expect(stub).ToNot(BeNil())
+			Expect(stub.outParameters).To(Equal([]interface{}{0, nil}))
+			Expect(stub.defaultOut).To(Equal([]interface{}{0, nil}))
+		})
+	})
+
+	Describe("planCall", func() {
+		It("reserves the call indexes of the stub and the matched custom arguments", func() {
+			ca := &CustomArguments{
+				stub:        stub,
+				argMatchers: []match.SupportedKindsMatcher{match.Exactly("apple"), match.Exactly(0)},
+			}
+			stub.customArgs = []*CustomArguments{ca}
+
+			plan := stub.planCall([]interface{}{"apple", 0}, reflect.TypeOf(fn))
+
+			Expect(plan.customArgs).To(BeIdenticalTo(ca))
+			Expect(stub.callIndex).To(Equal(1))
+			Expect(ca.callIndex).To(Equal(1))
+			Expect(plan.sequence).ToNot(BeZero())
+			Expect(ca.callCount).To(Equal(0))
+			Expect(stub.calls).To(BeEmpty())
+		})
+
+		It("captures the returning layer so later changes do not affect the call", func() {
+			stub.panics = true
+			stub.panicValue = "Ope"
+
+			plan := stub.planCall([]interface{}{"apple", 0}, reflect.TypeOf(fn))
+			stub.clearReturns()
+
+			Expect(plan.returning.panics).To(BeTrue())
+		})
+
+		It("plans zero values for an unexpected call to a strict stub", func() {
+			stub.strict = true
+			stub.customArgs = []*CustomArguments{{stub: stub, argMatchers: []match.SupportedKindsMatcher{match.Exactly("apple"), match.Exactly(0)}}}
+
+			plan := stub.planCall([]interface{}{"banana", 1}, reflect.TypeOf(fn))
+
+			Expect(plan.unexpected).To(BeTrue())
+			Expect(plan.configuredArgs).To(Equal(stub.customArgs))
+			Expect(plan.returning.out).To(Equal([]interface{}{0, nil}))
+		})
+	})
+
+	Describe("recordCall", func() {
+		It("appends the call and counts it towards the custom arguments", func() {
+			ca := &CustomArguments{stub: stub}
+
+			stub.recordCall(Call{args: []interface{}{"apple", 0}}, ca)
+
+			Expect(stub.calls).To(HaveLen(1))
+			Expect(ca.callCount).To(Equal(1))
+		})
+
+		It("wakes up anyone waiting for calls", func() {
+			recorded := make(chan struct{})
+			stub.callsRecorded = recorded
+
+			stub.recordCall(Call{}, nil)
+
+			Expect(recorded).To(BeClosed())
+			Expect(stub.callsRecorded).To(BeNil())
+		})
 	})
 
-	Describe("getReturnValues", func() {
+	Describe("getLayers", func() {
 		It("returns the Stub.OutParameters if no customArgs or onCalls exist", func() {
 			args := []interface{}{"Hello", 42}
 
-			result, maybeCustomArguments := stub.getReturnValues(args, reflect.TypeOf(fn))
+			ls, maybeCustomArguments := stub.getLayers(args, reflect.TypeOf(fn))
 
-			Expect(result).To(Equal([]interface{}{42, nil}))
+			Expect(ls.returning().out).To(Equal([]interface{}{42, nil}))
 			Expect(maybeCustomArguments).To(BeNil())
 		})
 
@@ -149,9 +232,9 @@ var _ = Describe("stub", func() {
 			args := []interface{}{"Hello", 42}
 			stub.customArgs = append(stub.customArgs, nil, nil, nil)
 
-			result, maybeCustomArguments := stub.getReturnValues(args, reflect.TypeOf(fn))
+			ls, maybeCustomArguments := stub.getLayers(args, reflect.TypeOf(fn))
 
-			Expect(result).To(Equal([]interface{}{42, nil}))
+			Expect(ls.returning().out).To(Equal([]interface{}{42, nil}))
 			Expect(maybeCustomArguments).To(BeNil())
 		})
 
@@ -170,9 +253,9 @@ var _ = Describe("stub", func() {
 					out:         []interface{}{98, nil},
 				})
 
-			result, maybeCustomArguments := stub.getReturnValues(args, reflect.TypeOf(fn))
+			ls, maybeCustomArguments := stub.getLayers(args, reflect.TypeOf(fn))
 
-			Expect(result).To(Equal([]interface{}{42, nil}))
+			Expect(ls.returning().out).To(Equal([]interface{}{42, nil}))
 			Expect(maybeCustomArguments).To(BeNil())
 		})
 
@@ -198,12 +281,33 @@ var _ = Describe("stub", func() {
 				expected,
 			)
 
-			result, maybeCustomArguments := stub.getReturnValues(args, reflect.TypeOf(fn))
+			ls, maybeCustomArguments := stub.getLayers(args, reflect.TypeOf(fn))
 
-			Expect(result).To(Equal([]interface{}{22, errors.New("I am an error")}))
+			Expect(ls.returning().out).To(Equal([]interface{}{22, errors.New("I am an error")}))
 			Expect(maybeCustomArguments).To(Equal(expected))
 		})
 
+		It("returns the layers ordered from the highest priority to the lowest", func() {
+			args := []interface{}{"apple", 0}
+			ca := &CustomArguments{
+				stub:        stub,
+				argMatchers: []match.SupportedKindsMatcher{match.Exactly("apple"), match.Exactly(0)},
+				out:         []interface{}{1, nil},
+				onCalls:     []*OnCall{{stub: stub, index: 0, out: []interface{}{2, nil}}},
+			}
+			stub.customArgs = []*CustomArguments{ca}
+			stub.onCalls = []*OnCall{{stub: stub, index: 0, out: []interface{}{3, nil}}}
+
+			ls, maybeCustomArguments := stub.getLayers(args, reflect.TypeOf(fn))
+
+			Expect(maybeCustomArguments).To(Equal(ca))
+			Expect(ls).To(HaveLen(4))
+			Expect(ls[0].out).To(Equal([]interface{}{2, nil}))
+			Expect(ls[1].out).To(Equal([]interface{}{1, nil}))
+			Expect(ls[2].out).To(Equal([]interface{}{3, nil}))
+			Expect(ls[3].out).To(Equal([]interface{}{42, nil}))
+		})
+
 		It("returns the the out parameters for the specific call index", func() {
 			args := []interface{}{"apples", 42}
 			stub.customArgs = append(
@@ -212,7 +316,7 @@ var _ = Describe("stub", func() {
 					stub:        stub,
 					argMatchers: []match.SupportedKindsMatcher{match.Exactly("apple"), match.Exactly(0)},
 					out:         []interface{}{0, errors.New("I am not an apple")},
-					callCount:   2,
+					callIndex:   2,
 					onCalls: []*OnCall{
 						{
 							stub:  stub,
@@ -222,16 +326,16 @@ var _ = Describe("stub", func() {
 					},
 				},
 			)
-			stub.calls = []Call{{}, {}, {}}
+			stub.callIndex = 3
 			stub.onCalls = append(stub.onCalls, &OnCall{
 				stub:  stub,
 				index: 3,
 				out:   []interface{}{22, errors.New("I am the first error")},
 			})
 
-			result, maybeCustomArguments := stub.getReturnValues(args, reflect.TypeOf(fn))
+			ls, maybeCustomArguments := stub.getLayers(args, reflect.TypeOf(fn))
 
-			Expect(result).To(Equal([]interface{}{22, errors.New("I am the first error")}))
+			Expect(ls.returning().out).To(Equal([]interface{}{22, errors.New("I am the first error")}))
 			Expect(maybeCustomArguments).To(BeNil())
 		})
 
@@ -241,7 +345,7 @@ var _ = Describe("stub", func() {
 				stub:        stub,
 				argMatchers: []match.SupportedKindsMatcher{match.Exactly("apple"), match.Exactly(0)},
 				out:         []interface{}{0, errors.New("I am not an apple")},
-				callCount:   2,
+				callIndex:   2,
 				onCalls: []*OnCall{
 					{
 						stub:  stub,
@@ -251,16 +355,16 @@ var _ = Describe("stub", func() {
 				},
 			}
 			stub.customArgs = append(stub.customArgs, expected)
-			stub.calls = []Call{{}, {}, {}}
+			stub.callIndex = 3
 			stub.onCalls = append(stub.onCalls, &OnCall{
 				stub:  stub,
 				index: 0,
 				out:   []interface{}{22, errors.New("I am the first error")},
 			})
 
-			result, maybeCustomArguments := stub.getReturnValues(args, reflect.TypeOf(fn))
+			ls, maybeCustomArguments := stub.getLayers(args, reflect.TypeOf(fn))
 
-			Expect(result).To(Equal([]interface{}{23, errors.New("I am the third not an apple")}))
+			Expect(ls.returning().out).To(Equal([]interface{}{23, errors.New("I am the third not an apple")}))
 			Expect(maybeCustomArguments).To(Equal(expected))
 		})
 	})
@@ -307,6 +411,16 @@ var _ = Describe("stub", func() {
 			Expect(argsProvided).To(Equal(expected))
 		})
 
+		It("reports a panic from inside the predicate of a matcher and returns the default out parameters", func() {
+			stub.testReporter = failTestReporter
+			stub.customArgs[0].argMatchers[0] = match.Typed(func(string) bool { panic("ope") })
+
+			out := stub.implementation([]reflect.Value{reflect.ValueOf("Hello"), reflect.ValueOf(0)})
+
+			Expect(out[0].Interface()).To(Equal(42))
+			Expect(failTestReporter.messages).To(Equal([]string{`mocka: predicate func(string) bool panicked with ope for "Hello"`}))
+		})
+
 		It("appends the call meta data for this call into the calls slice", func() {
 			args := []reflect.Value{reflect.ValueOf("Hello"), reflect.ValueOf(42)}
 
@@ -322,6 +436,138 @@ var _ = Describe("stub", func() {
 			Expect(ca.out).To(Equal([]interface{}{42, nil}))
 		})
 
+		It("stamps each call with an increasing sequence number", func() {
+			args := []reflect.Value{reflect.ValueOf("Hello"), reflect.ValueOf(42)}
+
+			_ = stub.implementation(args)
+			_ = stub.implementation(args)
+
+			Expect(stub.calls).To(HaveLen(2))
+			Expect(stub.calls[0].sequence).ToNot(BeZero())
+			Expect(stub.calls[1].sequence).To(BeNumerically(">", stub.calls[0].sequence))
+		})
+
+		It("records the time, caller and goroutine of each call", func() {
+			args := []reflect.Value{reflect.ValueOf("Hello"), reflect.ValueOf(42)}
+			before := time.Now()
+
+			_ = stub.implementation(args)
+
+			call := stub.calls[0]
+			Expect(call.timestamp).To(BeTemporally(">=", before))
+			Expect(call.caller).To(ContainSubstring("stub_test.go:"))
+			Expect(call.goroutine).To(Equal(currentGoroutineID()))
+			Expect(call.stack).To(BeEmpty())
+		})
+
+		It("records the stack of each call when capturing stacks", func() {
+			args := []reflect.Value{reflect.ValueOf("Hello"), reflect.ValueOf(42)}
+			stub.captureStack = true
+
+			_ = stub.implementation(args)
+
+			Expect(stub.calls[0].stack).To(ContainSubstring("stub_test.go"))
+		})
+
+		It("runs the exec function without holding the lock", func() {
+			args := []reflect.Value{reflect.ValueOf("Hello"), reflect.ValueOf(42)}
+			var callCount int
+			stub.execFunc = func([]interface{}) {
+				callCount = stub.CallCount()
+				_ = stub.GetCalls()
+			}
+
+			_ = stub.implementation(args)
+
+			Expect(callCount).To(Equal(0))
+			Expect(stub.CallCount()).To(Equal(1))
+		})
+
+		It("does not serialize concurrent callers while the exec function blocks", func() {
+			args := []reflect.Value{reflect.ValueOf("Hello"), reflect.ValueOf(42)}
+			blocked := make(chan struct{})
+			release := make(chan struct{})
+			stub.execFunc = func([]interface{}) {
+				blocked <- struct{}{}
+				<-release
+			}
+
+			go stub.implementation(args)
+			go stub.implementation(args)
+
+			Eventually(blocked).Should(Receive())
+			Eventually(blocked).Should(Receive())
+			close(release)
+
+			Expect(stub.WaitForCalls(2, time.Second)).To(BeTrue())
+		})
+
+		It("gives concurrent callers different call indexes", func() {
+			args := []reflect.Value{reflect.ValueOf("Hello"), reflect.ValueOf(42)}
+			stub.onCalls = []*OnCall{{stub: stub, index: 0, out: []interface{}{1, nil}}}
+			release := make(chan struct{})
+			stub.execFunc = func([]interface{}) {
+				<-release
+			}
+			results := make(chan interface{}, 2)
+			call := func() {
+				results <- stub.implementation(args)[0].Interface()
+			}
+
+			go call()
+			go call()
+
+			Eventually(func() int {
+				stub.lock.RLock()
+				defer stub.lock.RUnlock()
+				return stub.callIndex
+			}).Should(Equal(2))
+			close(release)
+
+			Expect([]interface{}{<-results, <-results}).To(ConsistOf(1, 42))
+		})
+
+		It("timestamps the call with the clock of the stub", func() {
+			clock := &mockClock{now: time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)}
+			stub.clock = clock
+
+			_ = stub.implementation([]reflect.Value{reflect.ValueOf("Hello"), reflect.ValueOf(42)})
+
+			Expect(stub.calls[0].timestamp).To(Equal(clock.now))
+		})
+
+		It("sleeps through the clock of the stub and records the measured delay", func() {
+			clock := &mockClock{}
+			stub.clock = clock
+			stub.setDelay(time.Minute, time.Minute)
+
+			_ = stub.implementation([]reflect.Value{reflect.ValueOf("Hello"), reflect.ValueOf(42)})
+
+			Expect(clock.sleeps).To(Equal([]time.Duration{time.Minute}))
+			Expect(stub.calls[0].delay).To(Equal(time.Minute))
+		})
+
+		It("uses the delay of the matched custom arguments over the delay of the stub", func() {
+			clock := &mockClock{}
+			stub.clock = clock
+			stub.setDelay(time.Minute, time.Minute)
+			stub.customArgs[0].setDelay(time.Second, time.Second)
+
+			_ = stub.implementation([]reflect.Value{reflect.ValueOf("custom"), reflect.ValueOf(0)})
+
+			Expect(clock.sleeps).To(Equal([]time.Duration{time.Second}))
+		})
+
+		It("does not sleep if the call is not delayed", func() {
+			clock := &mockClock{}
+			stub.clock = clock
+
+			_ = stub.implementation([]reflect.Value{reflect.ValueOf("Hello"), reflect.ValueOf(42)})
+
+			Expect(clock.sleeps).To(BeEmpty())
+			Expect(stub.calls[0].delay).To(BeZero())
+		})
+
 		It("returns the out parameters as reflection values", func() {
 			args := []reflect.Value{reflect.ValueOf("Hello"), reflect.ValueOf(42)}
 
@@ -349,6 +595,158 @@ var _ = Describe("stub", func() {
 			Expect(outInterfaces).To(Equal([]interface{}{42, nil}))
 		})
 
+		It("computes the out parameters with the return function", func() {
+			args := []reflect.Value{reflect.ValueOf("Hello"), reflect.ValueOf(2)}
+			stub.returnFunc = func(arguments []interface{}) []interface{} {
+				return []interface{}{arguments[1].(int) * 2, nil}
+			}
+
+			outValues := stub.implementation(args)
+
+			Expect(mapToInterfaces(outValues)).To(Equal([]interface{}{4, nil}))
+			Expect(stub.calls).To(HaveLen(1))
+			Expect(stub.calls[0].args).To(Equal([]interface{}{"Hello", 2}))
+			Expect(stub.calls[0].out).To(Equal([]interface{}{4, nil}))
+		})
+
+		It("reports an error and returns zero values if the return function returns invalid out parameters", func() {
+			stub.testReporter = failTestReporter
+			args := []reflect.Value{reflect.ValueOf("Hello"), reflect.ValueOf(2)}
+			stub.returnFunc = func([]interface{}) []interface{} {
+				return []interface{}{"4", nil}
+			}
+
+			outValues := stub.implementation(args)
+
+			Expect(mapToInterfaces(outValues)).To(Equal([]interface{}{0, nil}))
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: expected return values of type (int, error), but received (string, <nil>)",
+			}))
+		})
+
+		It("panics after capturing the call when the stub panics", func() {
+			args := []reflect.Value{reflect.ValueOf("custom"), reflect.ValueOf(0)}
+			stub.customArgs[0].panics = true
+			stub.customArgs[0].panicValue = "Ope"
+
+			var recovered interface{}
+			func() {
+				defer func() { recovered = recover() }()
+				_ = stub.implementation(args)
+			}()
+
+			Expect(recovered).To(Equal("Ope"))
+
+			Expect(stub.calls).To(HaveLen(1))
+			Expect(stub.calls[0].args).To(Equal([]interface{}{"custom", 0}))
+			Expect(stub.calls[0].out).To(BeNil())
+			Expect(stub.calls[0].panicked).To(BeTrue())
+			Expect(stub.calls[0].panicValue).To(Equal("Ope"))
+			Expect(stub.customArgs[0].callCount).To(Equal(1))
+		})
+
+		It("captures the call as panicked when the return function panics", func() {
+			args := []reflect.Value{reflect.ValueOf("Hello"), reflect.ValueOf(2)}
+			stub.returnFunc = func([]interface{}) []interface{} {
+				panic("Ope")
+			}
+
+			var recovered interface{}
+			func() {
+				defer func() { recovered = recover() }()
+				_ = stub.implementation(args)
+			}()
+
+			Expect(recovered).To(Equal("Ope"))
+			Expect(stub.calls).To(HaveLen(1))
+			Expect(stub.calls[0].args).To(Equal([]interface{}{"Hello", 2}))
+			Expect(stub.calls[0].panicked).To(BeTrue())
+			Expect(stub.calls[0].panicValue).To(Equal("Ope"))
+		})
+
+		It("captures the call as panicked and counts it towards the custom arguments when the exec function panics", func() {
+			args := []reflect.Value{reflect.ValueOf("custom"), reflect.ValueOf(0)}
+			stub.execFunc = func([]interface{}) {
+				panic("Ope")
+			}
+
+			var recovered interface{}
+			func() {
+				defer func() { recovered = recover() }()
+				_ = stub.implementation(args)
+			}()
+
+			Expect(recovered).To(Equal("Ope"))
+			Expect(stub.calls).To(HaveLen(1))
+			Expect(stub.calls[0].out).To(Equal([]interface{}{0, errors.New("Ope")}))
+			Expect(stub.calls[0].panicked).To(BeTrue())
+			Expect(stub.customArgs[0].callCount).To(Equal(1))
+		})
+
+		It("keeps the call indexes matching the captured calls after a call through panics", func() {
+			explode := func(n int) int {
+				panic("Ope")
+			}
+			s := Spy(GinkgoT(), &explode)
+			defer s.Restore()
+			s.OnCall(1).Return(10)
+
+			Expect(func() { _ = explode(1) }).To(Panic())
+			result := explode(2)
+
+			Expect(result).To(Equal(10))
+			Expect(s.CallCount()).To(Equal(2))
+			Expect(s.GetCall(0).Panicked()).To(BeTrue())
+			Expect(s.GetCall(1).Arguments()).To(Equal([]interface{}{2}))
+		})
+
+		It("captures the call without panicking when the exec function exits the goroutine", func() {
+			args := []reflect.Value{reflect.ValueOf("Hello"), reflect.ValueOf(2)}
+			stub.execFunc = func([]interface{}) {
+				runtime.Goexit()
+			}
+
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				_ = stub.implementation(args)
+			}()
+			<-done
+
+			Expect(stub.calls).To(HaveLen(1))
+			Expect(stub.calls[0].panicked).To(BeFalse())
+		})
+
+		It("calls the original function and captures the real out parameters when calling through", func() {
+			args := []reflect.Value{reflect.ValueOf("Hello"), reflect.ValueOf(2)}
+			stub.callThrough = true
+
+			outValues := stub.implementation(args)
+
+			Expect(mapToInterfaces(outValues)).To(Equal([]interface{}{7, nil}))
+			Expect(stub.calls).To(HaveLen(1))
+			Expect(stub.calls[0].args).To(Equal([]interface{}{"Hello", 2}))
+			Expect(stub.calls[0].out).To(Equal([]interface{}{7, nil}))
+		})
+
+		It("uses out parameters for custom arguments over calling through", func() {
+			args := []reflect.Value{reflect.ValueOf("custom"), reflect.ValueOf(0)}
+			stub.callThrough = true
+
+			outValues := stub.implementation(args)
+
+			Expect(mapToInterfaces(outValues)).To(Equal([]interface{}{0, errors.New("Ope")}))
+		})
+
+		It("calls the original function for custom arguments that call through", func() {
+			args := []reflect.Value{reflect.ValueOf("custom-"), reflect.ValueOf(0)}
+			stub.customArgs[1].callThrough = true
+
+			outValues := stub.implementation(args)
+
+			Expect(mapToInterfaces(outValues)).To(Equal([]interface{}{7, nil}))
+		})
+
 		Context("variadic function", func() {
 			BeforeEach(func() {
 				fn := func(str string, opts ...string) (int, error) {
@@ -361,6 +759,15 @@ var _ = Describe("stub", func() {
 				stub.calls = []Call{}
 			})
 
+			It("calls the original function with the variadic arguments when calling through", func() {
+				args := []reflect.Value{reflect.ValueOf("Hello"), reflect.ValueOf([]string{"A", "B"})}
+				stub.callThrough = true
+
+				outValues := stub.implementation(args)
+
+				Expect(mapToInterfaces(outValues)).To(Equal([]interface{}{7, nil}))
+			})
+
 			It("appends the call meta data omitting the missing variadic arguments", func() {
 				args := []reflect.Value{reflect.ValueOf("Hello")}
 
@@ -389,6 +796,48 @@ var _ = Describe("stub", func() {
 				Expect(ca.args).To(Equal([]interface{}{"Hello", "A", "B"}))
 			})
 		})
+		Context("strict", func() {
+			BeforeEach(func() {
+				stub.testReporter = failTestReporter
+				stub.strict = true
+				stub.customArgs[0].arguments = []interface{}{"custom", 0}
+				stub.customArgs[1].arguments = []interface{}{match.StringPrefix("custom-"), 0}
+			})
+
+			It("reports the arguments of a call that matches no custom arguments and why they did not match", func() {
+				_ = stub.implementation([]reflect.Value{reflect.ValueOf("Hello"), reflect.ValueOf(42)})
+
+				Expect(failTestReporter.messages).To(Equal([]string{
+					"mocka: unexpected call with arguments (\"Hello\", 42), expected one of:\n" +
+						"\t(\"custom\", 0): argument 0: expected \"custom\" but got \"Hello\"\n" +
+						"\t(string with prefix \"custom-\", 0): argument 0: expected string with prefix \"custom-\" but got \"Hello\"",
+				}))
+			})
+
+			It("returns the zero values of the out parameters for a call that matches no custom arguments", func() {
+				result := stub.implementation([]reflect.Value{reflect.ValueOf("Hello"), reflect.ValueOf(42)})
+
+				Expect(result).To(HaveLen(2))
+				Expect(result[0].Interface()).To(Equal(0))
+				Expect(result[1].Interface()).To(BeNil())
+				Expect(stub.calls[0].out).To(Equal([]interface{}{0, nil}))
+			})
+
+			It("returns the custom out parameters for a call that matches custom arguments", func() {
+				result := stub.implementation([]reflect.Value{reflect.ValueOf("custom"), reflect.ValueOf(0)})
+
+				Expect(failTestReporter.messages).To(BeEmpty())
+				Expect(result[1].Interface()).To(MatchError("Ope"))
+			})
+
+			It("reports every call when no custom arguments are configured", func() {
+				stub.customArgs = nil
+
+				_ = stub.implementation([]reflect.Value{reflect.ValueOf("Hello"), reflect.ValueOf(42)})
+
+				Expect(failTestReporter.messages).To(ConsistOf("mocka: unexpected call with arguments (\"Hello\", 42), no arguments were configured"))
+			})
+		})
 	})
 
 	Describe("Return", func() {
@@ -452,6 +901,34 @@ var _ = Describe("stub", func() {
 		})
 	})
 
+	Describe("WaitForCalls", func() {
+		It("returns true immediately if the stub was already called enough times", func() {
+			stub.calls = []Call{{}, {}}
+
+			Expect(stub.WaitForCalls(2, 0)).To(BeTrue())
+		})
+
+		It("waits for calls made from other goroutines", func() {
+			args := []reflect.Value{reflect.ValueOf("Hello"), reflect.ValueOf(42)}
+
+			go func() {
+				for i := 0; i < 3; i++ {
+					_ = stub.implementation(args)
+				}
+			}()
+
+			Expect(stub.WaitForCalls(3, time.Second)).To(BeTrue())
+			Expect(stub.CallCount()).To(Equal(3))
+		})
+
+		It("returns false if the timeout elapses first", func() {
+			args := []reflect.Value{reflect.ValueOf("Hello"), reflect.ValueOf(42)}
+			_ = stub.implementation(args)
+
+			Expect(stub.WaitForCalls(2, 10*time.Millisecond)).To(BeFalse())
+		})
+	})
+
 	Describe("GetCalls", func() {
 		It("returns all the call meta data made to the stub", func() {
 			stub.calls = append(stub.calls,
@@ -731,46 +1208,228 @@ var _ = Describe("stub", func() {
 		})
 	})
 
-	Describe("OnCall", func() {
-		BeforeEach(func() {
-			stub.onCalls = []*OnCall{
-				{stub: stub, index: 1},
-				{stub: stub, index: 2},
-				{stub: stub, index: 0},
-			}
+	Describe("CalledWith", func() {
+		It("returns true if any call matches the arguments", func() {
+			stub.calls = []Call{{args: []interface{}{"hi", 1}}, {args: []interface{}{"hello", 2}}}
+
+			Expect(stub.CalledWith("hello", match.IntGreaterThan(1))).To(BeTrue())
+			Expect(stub.CalledWith("hello", 1)).To(BeFalse())
 		})
 
-		It("returns a pointer to an onCall struct", func() {
-			result := stub.OnCall(5)
+		It("returns false and reports an error if the arguments are not valid", func() {
+			stub.testReporter = failTestReporter
+			stub.calls = []Call{{args: []interface{}{"hello", 2}}}
 
-			Expect(*result).To(Equal(OnCall{stub: stub, index: 5}))
+			Expect(stub.CalledWith(2, "hello")).To(BeFalse())
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: expected arguments of type (string, int), but received (int, string)",
+			}))
 		})
+	})
 
-		It("appends the new onCall struct to the onCalls slice", func() {
-			_ = stub.OnCall(5)
+	Describe("CalledWithExactly", func() {
+		It("returns true if any call has exactly the arguments", func() {
+			var variadicFn func(string, ...int) error
+			stub.functionPtr = &variadicFn
+			stub.calls = []Call{{args: []interface{}{"SELECT 1", []int{1, 2}}}}
 
-			Expect(stub.onCalls).To(HaveLen(4))
+			Expect(stub.CalledWithExactly("SELECT 1", 1, 2)).To(BeTrue())
+			Expect(stub.CalledWithExactly("SELECT 1", 1)).To(BeFalse())
+			Expect(stub.CalledWith("SELECT 1", 1)).To(BeTrue())
 		})
 
-		It("returns an existing onCall object if one exists for that index", func() {
-			result := stub.OnCall(2)
+		It("gives the arguments the same meaning as WithArgs", func() {
+			query := func(q string, args ...int) int {
+				return 0
+			}
+			s := newStub(GinkgoT(), &query, []interface{}{0})
+			defer s.Restore()
+			s.WithArgs("SELECT 1").Return(1)
 
-			Expect(stub.onCalls).To(HaveLen(3))
-			Expect(*result).To(Equal(OnCall{stub: stub, index: 2}))
+			result := query("SELECT 1", 1, 2)
+
+			Expect(result).To(Equal(0))
+			Expect(s.CalledWithExactly("SELECT 1")).To(BeFalse())
+			Expect(s.CalledWithExactly("SELECT 1", 1, 2)).To(BeTrue())
+			Expect(s.CalledWith("SELECT 1")).To(BeTrue())
 		})
 	})
 
-	Describe("OnFirstCall", func() {
-		It("creates a new onCall with a 0 index", func() {
-			stub.onCalls = []*OnCall{
-				{stub: stub, index: 1},
-				{stub: stub, index: 2},
-			}
+	Describe("AlwaysCalledWith", func() {
+		It("returns true if every call matches the arguments", func() {
+			stub.calls = []Call{{args: []interface{}{"hello", 1}}, {args: []interface{}{"hello", 2}}}
 
-			result := stub.OnFirstCall()
+			Expect(stub.AlwaysCalledWith("hello", match.Anything())).To(BeTrue())
+			Expect(stub.AlwaysCalledWith("hello", 1)).To(BeFalse())
+		})
 
-			Expect(stub.onCalls).To(HaveLen(3))
-			Expect(*result).To(Equal(OnCall{stub: stub, index: 0}))
+		It("returns false if the function was not called", func() {
+			Expect(stub.AlwaysCalledWith("hello", 1)).To(BeFalse())
+		})
+	})
+
+	Describe("NeverCalledWith", func() {
+		It("returns true if no call matches the arguments", func() {
+			stub.calls = []Call{{args: []interface{}{"hello", 1}}}
+
+			Expect(stub.NeverCalledWith("hello", 2)).To(BeTrue())
+			Expect(stub.NeverCalledWith("hello", 1)).To(BeFalse())
+		})
+	})
+
+	Describe("CalledWithAt", func() {
+		It("returns true if the call at the index matches the arguments", func() {
+			stub.calls = []Call{{args: []interface{}{"hi", 1}}, {args: []interface{}{"hello", 2}}}
+
+			Expect(stub.CalledWithAt(1, "hello", 2)).To(BeTrue())
+			Expect(stub.CalledWithAt(0, "hello", 2)).To(BeFalse())
+			Expect(stub.CalledWithAt(2, "hello", 2)).To(BeFalse())
+		})
+	})
+
+	Describe("AssertCalledWith", func() {
+		It("returns true without reporting if any call matches the arguments", func() {
+			stub.testReporter = failTestReporter
+			stub.calls = []Call{{args: []interface{}{"hello", 1}}}
+
+			Expect(stub.AssertCalledWith("hello", 1)).To(BeTrue())
+			Expect(failTestReporter.messages).To(BeEmpty())
+		})
+
+		It("reports the arguments of every call and why they did not match if no call matches the arguments", func() {
+			stub.testReporter = failTestReporter
+			stub.calls = []Call{{args: []interface{}{"hi", 1}}, {args: []interface{}{"hey", 2}}}
+
+			Expect(stub.AssertCalledWith("hello", 1)).To(BeFalse())
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: expected func(string, int) (int, error) to be called with (\"hello\", 1), but it was called with:\n" +
+					"\t(\"hi\", 1): argument 0: expected \"hello\" but got \"hi\"\n" +
+					"\t(\"hey\", 2): argument 0: expected \"hello\" but got \"hey\"",
+			}))
+		})
+	})
+
+	Describe("AssertCalledWithExactly", func() {
+		It("reports that the function was never called", func() {
+			stub.testReporter = failTestReporter
+
+			Expect(stub.AssertCalledWithExactly("hello", 1)).To(BeFalse())
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: expected func(string, int) (int, error) to be called with exactly (\"hello\", 1), but it was never called",
+			}))
+		})
+	})
+
+	Describe("AssertAlwaysCalledWith", func() {
+		It("reports the arguments of every call if any call does not match the arguments", func() {
+			stub.testReporter = failTestReporter
+			stub.calls = []Call{{args: []interface{}{"hello", 1}}, {args: []interface{}{"hey", 2}}}
+
+			Expect(stub.AssertAlwaysCalledWith("hello", 1)).To(BeFalse())
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: expected func(string, int) (int, error) to always be called with (\"hello\", 1), but it was called with:\n\t(\"hello\", 1)\n\t(\"hey\", 2): argument 0: expected \"hello\" but got \"hey\"",
+			}))
+		})
+	})
+
+	Describe("AssertNeverCalledWith", func() {
+		It("reports the arguments of every call if any call matches the arguments", func() {
+			stub.testReporter = failTestReporter
+			stub.calls = []Call{{args: []interface{}{"hello", 1}}}
+
+			Expect(stub.AssertNeverCalledWith("hello", 1)).To(BeFalse())
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: expected func(string, int) (int, error) to never be called with (\"hello\", 1), but it was called with:\n\t(\"hello\", 1)",
+			}))
+		})
+	})
+
+	Describe("AssertCalledWithAt", func() {
+		It("reports the arguments of every call if the call at the index does not match the arguments", func() {
+			stub.testReporter = failTestReporter
+			stub.calls = []Call{{args: []interface{}{"hello", 1}}}
+
+			Expect(stub.AssertCalledWithAt(1, "hello", 1)).To(BeFalse())
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: expected func(string, int) (int, error) to be called with (\"hello\", 1) at call index 1, but it was called with:\n\t(\"hello\", 1)",
+			}))
+		})
+	})
+
+	Describe("Expect", func() {
+		It("appends a new expectation for the stub", func() {
+			e := stub.Expect()
+
+			Expect(stub.expectations).To(Equal([]*Expectation{e}))
+			Expect(e.customArgs).To(BeNil())
+		})
+	})
+
+	Describe("Verify", func() {
+		It("returns true if all expectations are met", func() {
+			stub.testReporter = failTestReporter
+			stub.Expect().Once()
+			stub.calls = []Call{{}}
+
+			Expect(stub.Verify()).To(BeTrue())
+			Expect(failTestReporter.messages).To(BeEmpty())
+		})
+
+		It("reports all unmet expectations in a single failure", func() {
+			stub.testReporter = failTestReporter
+			stub.Expect().Never()
+			stub.WithArgs("apple", 0).Expect().Once()
+			stub.calls = []Call{{}}
+
+			Expect(stub.Verify()).To(BeFalse())
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: 2 unmet expectation(s):\n" +
+					"\texpected func(string, int) (int, error) to be called exactly 0 times, but it was called 1 time\n" +
+					"\texpected func(string, int) (int, error) with arguments (\"apple\", 0) to be called exactly 1 time, but it was called 0 times",
+			}))
+		})
+	})
+
+	Describe("OnCall", func() {
+		BeforeEach(func() {
+			stub.onCalls = []*OnCall{
+				{stub: stub, index: 1},
+				{stub: stub, index: 2},
+				{stub: stub, index: 0},
+			}
+		})
+
+		It("returns a pointer to an onCall struct", func() {
+			result := stub.OnCall(5)
+
+			Expect(*result).To(Equal(OnCall{stub: stub, index: 5}))
+		})
+
+		It("appends the new onCall struct to the onCalls slice", func() {
+			_ = stub.OnCall(5)
+
+			Expect(stub.onCalls).To(HaveLen(4))
+		})
+
+		It("returns an existing onCall object if one exists for that index", func() {
+			result := stub.OnCall(2)
+
+			Expect(stub.onCalls).To(HaveLen(3))
+			Expect(*result).To(Equal(OnCall{stub: stub, index: 2}))
+		})
+	})
+
+	Describe("OnFirstCall", func() {
+		It("creates a new onCall with a 0 index", func() {
+			stub.onCalls = []*OnCall{
+				{stub: stub, index: 1},
+				{stub: stub, index: 2},
+			}
+
+			result := stub.OnFirstCall()
+
+			Expect(stub.onCalls).To(HaveLen(3))
+			Expect(*result).To(Equal(OnCall{stub: stub, index: 0}))
 		})
 
 		It("returns the existing first call", func() {
@@ -841,6 +1500,614 @@ var _ = Describe("stub", func() {
 		})
 	})
 
+	Describe("ReturnError", func() {
+		It("returns the error with the zero values for the other out parameters", func() {
+			stub.callThrough = true
+
+			stub.ReturnError(errors.New("Ope"))
+
+			Expect(stub.outParameters).To(Equal([]interface{}{0, errors.New("Ope")}))
+			Expect(stub.callThrough).To(BeFalse())
+		})
+
+		It("reports an error if the function does not return an error as its last out parameter", func() {
+			var parse func(string) (error, int)
+			stub.functionPtr = &parse
+			stub.testReporter = failTestReporter
+
+			stub.ReturnError(errors.New("Ope"))
+
+			Expect(stub.outParameters).To(Equal([]interface{}{42, nil}))
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: expected func(string) (error, int) to have an error as its last return value to use ReturnError",
+			}))
+		})
+	})
+
+	Describe("zeroValuesWithError", func() {
+		It("returns the zero values with the error as the last out parameter", func() {
+			var fn func() ([]string, *Thing, error)
+
+			out, ok := zeroValuesWithError(reflect.TypeOf(fn), errors.New("Ope"))
+
+			Expect(ok).To(BeTrue())
+			Expect(out).To(Equal([]interface{}{[]string(nil), (*Thing)(nil), errors.New("Ope")}))
+		})
+
+		It("returns false if the function has no out parameters", func() {
+			var fn func()
+
+			_, ok := zeroValuesWithError(reflect.TypeOf(fn), errors.New("Ope"))
+
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("ReturnZero", func() {
+		It("replaces the out parameters with the zero values and stops calling through", func() {
+			stub.callThrough = true
+
+			stub.ReturnZero()
+
+			Expect(stub.callThrough).To(BeFalse())
+			Expect(stub.outParameters).To(Equal([]interface{}{0, nil}))
+		})
+	})
+
+	Describe("ReturnSmartDefaults", func() {
+		It("assigns a return function that returns new smart values on every call", func() {
+			var lookup func(string) (map[string]int, []string, *Thing, error)
+			stub.functionPtr = &lookup
+
+			stub.ReturnSmartDefaults()
+
+			first := stub.returnFunc(nil)
+			second := stub.returnFunc(nil)
+			Expect(first).To(Equal([]interface{}{map[string]int{}, []string{}, &Thing{}, nil}))
+			Expect(first[2]).ToNot(BeIdenticalTo(second[2]))
+		})
+
+		It("is undone by replacing the out parameters", func() {
+			stub.ReturnSmartDefaults()
+
+			stub.Return(22, nil)
+
+			Expect(stub.returnFunc).To(BeNil())
+		})
+	})
+
+	Describe("smartValues", func() {
+		It("returns empty slices and maps, pointers to zero values and zero values for any other type", func() {
+			var fn func() ([]int, map[int]int, *int, Thing, error, chan int, int)
+
+			values := smartValues(reflect.TypeOf(fn))
+
+			Expect(values).To(Equal([]interface{}{[]int{}, map[int]int{}, new(int), Thing{}, nil, (chan int)(nil), 0}))
+			Expect(values[0]).ToNot(BeNil())
+			Expect(values[1]).ToNot(BeNil())
+		})
+	})
+
+	Describe("ReturnFunc", func() {
+		It("assigns the return function and stops calling through", func() {
+			stub.callThrough = true
+
+			stub.ReturnFunc(func([]interface{}) []interface{} {
+				return []interface{}{1, nil}
+			})
+
+			Expect(stub.callThrough).To(BeFalse())
+			Expect(stub.returnFunc(nil)).To(Equal([]interface{}{1, nil}))
+		})
+
+		It("is undone by replacing the out parameters", func() {
+			stub.ReturnFunc(func([]interface{}) []interface{} {
+				return []interface{}{1, nil}
+			})
+
+			stub.Return(22, nil)
+
+			Expect(stub.returnFunc).To(BeNil())
+		})
+	})
+
+	Describe("Panics", func() {
+		It("makes the stub panic with the provided value", func() {
+			stub.callThrough = true
+
+			stub.Panics("Ope")
+
+			Expect(stub.callThrough).To(BeFalse())
+			Expect(stub.panics).To(BeTrue())
+			Expect(stub.panicValue).To(Equal("Ope"))
+		})
+
+		It("is undone by replacing the out parameters", func() {
+			stub.Panics("Ope")
+
+			stub.Return(22, nil)
+
+			Expect(stub.panics).To(BeFalse())
+		})
+	})
+
+	Describe("Delay", func() {
+		It("delays the call by the duration", func() {
+			stub.Delay(time.Second)
+
+			Expect(stub.delayed).To(BeTrue())
+			Expect(stub.minDelay).To(Equal(time.Second))
+			Expect(stub.maxDelay).To(Equal(time.Second))
+		})
+	})
+
+	Describe("DelayBetween", func() {
+		It("delays the call by a duration between the minimum and maximum", func() {
+			stub.DelayBetween(time.Millisecond, time.Second)
+
+			Expect(stub.minDelay).To(Equal(time.Millisecond))
+			Expect(stub.maxDelay).To(Equal(time.Second))
+		})
+
+		It("reports an invalid delay", func() {
+			stub.testReporter = failTestReporter
+
+			stub.DelayBetween(-time.Second, time.Second)
+
+			Expect(stub.delayed).To(BeFalse())
+			Expect(failTestReporter.messages).To(ConsistOf("mocka: expected a delay of at least 0s, but received -1s"))
+		})
+	})
+
+	Describe("UseClock", func() {
+		It("replaces the clock of the stub", func() {
+			clock := &mockClock{}
+
+			stub.UseClock(clock)
+
+			Expect(stub.clock).To(BeIdenticalTo(clock))
+		})
+	})
+
+	Describe("SetArg", func() {
+		It("reports an index out of range", func() {
+			stub.testReporter = failTestReporter
+
+			stub.SetArg(2, 42)
+
+			Expect(stub.setArgs).To(BeEmpty())
+			Expect(failTestReporter.messages).To(ConsistOf("mocka: argument index 2 is out of range for arguments of type (string, int)"))
+		})
+
+		It("writes the value through the pointer argument when called", func() {
+			unmarshal := func(data []byte, v interface{}) error {
+				return nil
+			}
+			s := newStub(GinkgoT(), &unmarshal, []interface{}{nil})
+			defer s.Restore()
+			var out map[string]int
+
+			s.SetArg(1, map[string]int{"apple": 1})
+			err := unmarshal([]byte("{}"), &out)
+
+			Expect(err).To(BeNil())
+			Expect(out).To(Equal(map[string]int{"apple": 1}))
+		})
+
+		It("writes an untyped constant through a pointer to another numeric type", func() {
+			parse := func(n *int64) error {
+				return nil
+			}
+			reporter := &mockTestReporter{}
+			s := newStub(reporter, &parse, []interface{}{nil})
+			defer s.Restore()
+			var n int64
+
+			s.SetArg(0, 7)
+			_ = parse(&n)
+
+			Expect(n).To(Equal(int64(7)))
+			Expect(reporter.messages).To(BeEmpty())
+		})
+
+		It("writes the value through a variadic pointer argument when called", func() {
+			scan := func(dest ...interface{}) error {
+				return nil
+			}
+			s := newStub(GinkgoT(), &scan, []interface{}{nil})
+			defer s.Restore()
+			var id int
+			var name string
+
+			s.SetArg(1, "apple")
+			s.WithArgs(match.Anything(), match.Anything()).SetArg(0, 42)
+			_ = scan(&id, &name)
+
+			Expect(id).To(Equal(42))
+			Expect(name).To(Equal("apple"))
+		})
+
+		It("reports a variadic pointer argument that is missing when called", func() {
+			scan := func(dest ...interface{}) error {
+				return nil
+			}
+			reporter := &mockTestReporter{}
+			s := newStub(reporter, &scan, []interface{}{nil})
+			defer s.Restore()
+			var id int
+
+			s.SetArg(1, "apple")
+			_ = scan(&id)
+
+			Expect(reporter.messages).To(ConsistOf("mocka: could not set argument 1, the function was called with 1 argument(s)"))
+		})
+	})
+
+	Describe("CallsArg", func() {
+		It("reports arguments the function argument cannot be called with", func() {
+			walk := func(root string, walkFn func(string) error) error {
+				return nil
+			}
+			reporter := &mockTestReporter{}
+			s := newStub(reporter, &walk, []interface{}{nil})
+			defer s.Restore()
+
+			s.CallsArg(1, 42)
+
+			Expect(s.callsArgs).To(BeEmpty())
+			Expect(reporter.messages).To(ConsistOf("mocka: expected arguments of type (string) to call argument 1, but received (int)"))
+		})
+
+		It("calls the function argument with untyped constants converted to its argument types", func() {
+			retry := func(attempt func(int64)) {}
+			reporter := &mockTestReporter{}
+			s := newStub(reporter, &retry, nil)
+			defer s.Restore()
+			var attempts []int64
+
+			s.CallsArg(0, 3)
+			retry(func(n int64) { attempts = append(attempts, n) })
+
+			Expect(attempts).To(Equal([]int64{3}))
+			Expect(reporter.messages).To(BeEmpty())
+		})
+
+		It("calls the function argument in sequence and captures its return values", func() {
+			walk := func(root string, walkFn func(string) error) error {
+				return nil
+			}
+			s := newStub(GinkgoT(), &walk, []interface{}{nil})
+			defer s.Restore()
+			var visited []string
+
+			s.CallsArg(1, "/a")
+			s.CallsArg(1, "/b")
+			_ = walk("/", func(path string) error {
+				visited = append(visited, path)
+				return errors.New("Ope " + path)
+			})
+
+			Expect(visited).To(Equal([]string{"/a", "/b"}))
+			Expect(s.GetFirstCall().CallbackResults()).To(Equal([][]interface{}{
+				{errors.New("Ope /a")},
+				{errors.New("Ope /b")},
+			}))
+		})
+
+		It("calls the function argument passed as a variadic argument", func() {
+			on := func(event string, handlers ...func(string)) {}
+			s := newStub(GinkgoT(), &on, nil)
+			defer s.Restore()
+			var received string
+
+			s.CallsArg(2, "payload")
+			on("message", func(string) {}, func(payload string) {
+				received = payload
+			})
+
+			Expect(received).To(Equal("payload"))
+		})
+	})
+
+	Describe("CallsArgAsync", func() {
+		It("calls the function argument on a new goroutine and captures its return values", func() {
+			do := func(f func() int) {}
+			s := newStub(GinkgoT(), &do, nil)
+			defer s.Restore()
+			release := make(chan struct{})
+
+			s.CallsArgAsync(0)
+			do(func() int {
+				<-release
+				return 42
+			})
+
+			Expect(s.GetFirstCall().CallbackResults()).To(BeEmpty())
+			close(release)
+			Eventually(func() [][]interface{} {
+				return s.GetFirstCall().CallbackResults()
+			}).Should(Equal([][]interface{}{{42}}))
+		})
+
+		It("waits for the function argument to complete", func() {
+			do := func(f func() int) {}
+			s := newStub(GinkgoT(), &do, nil)
+			defer s.Restore()
+			release := make(chan struct{})
+
+			s.CallsArgAsync(0)
+			do(func() int {
+				<-release
+				return 42
+			})
+
+			Expect(s.GetFirstCall().WaitForCallbacks(time.Millisecond)).To(BeFalse())
+			close(release)
+			Expect(s.GetFirstCall().WaitForCallbacks(time.Second)).To(BeTrue())
+			Expect(s.GetFirstCall().CallbackResults()).To(Equal([][]interface{}{{42}}))
+		})
+
+		It("reports a panicking function argument instead of crashing", func() {
+			reporter := &mockTestReporter{}
+			do := func(f func()) {}
+			s := newStub(reporter, &do, nil)
+			defer s.Restore()
+
+			s.CallsArgAsync(0)
+			do(func() { panic("Ope") })
+
+			Expect(s.GetFirstCall().WaitForCallbacks(time.Second)).To(BeTrue())
+			Expect(reporter.messages).To(Equal([]string{"mocka: asynchronous call of argument 0 panicked: Ope"}))
+		})
+	})
+
+	Describe("ReturnsInOrder", func() {
+		It("keeps the out parameters and returns the values in order", func() {
+			sequence := stub.ReturnsInOrder([]interface{}{1, nil}, []interface{}{2, nil})
+
+			Expect(stub.outParameters).To(Equal([]interface{}{42, nil}))
+			Expect(stub.sequence).To(BeIdenticalTo(sequence))
+			Expect(sequence.values).To(Equal([][]interface{}{{1, nil}, {2, nil}}))
+		})
+
+		It("reports invalid out parameters", func() {
+			stub.testReporter = failTestReporter
+
+			stub.ReturnsInOrder([]interface{}{1, nil}, []interface{}{"2"})
+
+			Expect(stub.sequence).To(BeNil())
+			Expect(failTestReporter.messages).To(HaveLen(1))
+		})
+
+		It("returns the values in order and falls back to the out parameters of the stub", func() {
+			s := newStub(GinkgoT(), &fn, []interface{}{42, nil})
+			defer s.Restore()
+
+			s.ReturnsInOrder([]interface{}{1, nil}, []interface{}{2, nil}).FallBackToDefault()
+
+			results := make([]int, 3)
+			for i := range results {
+				results[i], _ = fn("", 0)
+			}
+
+			Expect(results).To(Equal([]int{1, 2, 42}))
+		})
+
+		It("advances only for calls that are not decided by custom arguments", func() {
+			s := newStub(GinkgoT(), &fn, []interface{}{42, nil})
+			defer s.Restore()
+
+			s.ReturnsInOrder([]interface{}{1, nil}, []interface{}{2, nil})
+			s.WithArgs("custom", 0).Return(100, nil)
+
+			first, _ := fn("", 0)
+			custom, _ := fn("custom", 0)
+			second, _ := fn("", 0)
+
+			Expect([]int{first, custom, second}).To(Equal([]int{1, 100, 2}))
+		})
+
+		It("falls back from the custom arguments sequence to the stub", func() {
+			s := newStub(GinkgoT(), &fn, []interface{}{42, nil})
+			defer s.Restore()
+
+			s.WithArgs("custom", 0).ReturnsInOrder([]interface{}{1, nil}).FallBackToDefault()
+
+			first, _ := fn("custom", 0)
+			second, _ := fn("custom", 0)
+
+			Expect([]int{first, second}).To(Equal([]int{1, 42}))
+		})
+
+		It("is undone by replacing the out parameters", func() {
+			stub.ReturnsInOrder([]interface{}{1, nil})
+
+			stub.Return(22, nil)
+
+			Expect(stub.sequence).To(BeNil())
+		})
+	})
+
+	Describe("CallThrough", func() {
+		It("makes the stub call through to the original function", func() {
+			stub.CallThrough()
+
+			Expect(stub.callThrough).To(BeTrue())
+		})
+
+		It("is undone by replacing the out parameters", func() {
+			stub.CallThrough()
+			stub.Return(22, nil)
+
+			Expect(stub.callThrough).To(BeFalse())
+		})
+
+		It("reports an error if the original function is nil", func() {
+			var nilFn func(string, int) (int, error)
+			s := newStub(failTestReporter, &nilFn, nil)
+			defer s.Restore()
+
+			s.CallThrough()
+
+			Expect(s.callThrough).To(BeFalse())
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: could not call through to the original function, the function is nil",
+			}))
+		})
+	})
+
+	Describe("CaptureStack", func() {
+		It("makes the stub capture the stack of every call", func() {
+			stub.CaptureStack()
+
+			Expect(stub.captureStack).To(BeTrue())
+		})
+	})
+
+	Describe("Strict", func() {
+		It("makes the stub strict", func() {
+			stub.Strict()
+
+			Expect(stub.strict).To(BeTrue())
+		})
+	})
+
+	Describe("ResetHistory", func() {
+		It("clears the calls and starts the call indexes and sequences over", func() {
+			ca := &CustomArguments{stub: stub, callIndex: 2, callCount: 2}
+			ca.sequence = &Sequence{position: 2}
+			stub.customArgs = []*CustomArguments{ca, nil}
+			stub.calls = []Call{{}, {}, {}}
+			stub.callIndex = 3
+			stub.sequence = &Sequence{position: 1}
+
+			stub.ResetHistory()
+
+			Expect(stub.calls).To(BeNil())
+			Expect(stub.callIndex).To(BeZero())
+			Expect(stub.sequence.position).To(BeZero())
+			Expect(ca.callIndex).To(BeZero())
+			Expect(ca.callCount).To(BeZero())
+			Expect(ca.sequence.position).To(BeZero())
+		})
+
+		It("keeps the behavior of the stub", func() {
+			stub.customArgs = []*CustomArguments{{stub: stub}}
+			stub.onCalls = []*OnCall{{stub: stub}}
+
+			stub.ResetHistory()
+
+			Expect(stub.customArgs).To(HaveLen(1))
+			Expect(stub.onCalls).To(HaveLen(1))
+			Expect(stub.outParameters).To(Equal([]interface{}{42, nil}))
+		})
+
+		It("applies the call indexes from the first call again", func() {
+			s := newStub(GinkgoT(), &fn, []interface{}{42, nil})
+			defer s.Restore()
+			s.OnFirstCall().Return(1, nil)
+
+			first, _ := fn("", 0)
+			s.ResetHistory()
+			again, _ := fn("", 0)
+
+			Expect([]int{first, again}).To(Equal([]int{1, 1}))
+			Expect(s.CallCount()).To(Equal(1))
+		})
+	})
+
+	Describe("ResetBehavior", func() {
+		It("removes the behavior and returns the out parameters the stub was created with", func() {
+			stub.defaultOut = []interface{}{1, nil}
+			stub.Return(22, nil)
+			stub.Panics("Ope")
+			stub.Delay(time.Second)
+			stub.customArgs = []*CustomArguments{{stub: stub}}
+			stub.onCalls = []*OnCall{{stub: stub}}
+			stub.execFunc = nil
+
+			stub.ResetBehavior()
+
+			Expect(stub.outParameters).To(Equal([]interface{}{1, nil}))
+			Expect(stub.behavior).To(Equal(behavior{}))
+			Expect(stub.customArgs).To(BeNil())
+			Expect(stub.onCalls).To(BeNil())
+			Expect(stub.execFunc).ToNot(BeNil())
+		})
+
+		It("keeps calling through if the stub is a spy", func() {
+			stub.spy = true
+			stub.Return(22, nil)
+
+			stub.ResetBehavior()
+
+			Expect(stub.callThrough).To(BeTrue())
+		})
+
+		It("keeps the calls and expectations of the stub", func() {
+			stub.calls = []Call{{}}
+			stub.Expect()
+
+			stub.ResetBehavior()
+
+			Expect(stub.calls).To(HaveLen(1))
+			Expect(stub.expectations).To(HaveLen(1))
+		})
+
+		It("removes the expectations of the removed custom arguments", func() {
+			stubExpectation := stub.Expect()
+			stub.WithArgs("hello", 1).Expect()
+
+			stub.ResetBehavior()
+
+			Expect(stub.expectations).To(Equal([]*Expectation{stubExpectation}))
+		})
+	})
+
+	Describe("Reset", func() {
+		It("clears the calls and the behavior of the stub", func() {
+			stub.defaultOut = []interface{}{1, nil}
+			stub.calls = []Call{{}}
+			stub.callIndex = 1
+			stub.Return(22, nil)
+
+			stub.Reset()
+
+			Expect(stub.calls).To(BeNil())
+			Expect(stub.callIndex).To(BeZero())
+			Expect(stub.outParameters).To(Equal([]interface{}{1, nil}))
+		})
+
+		It("removes the expectations of the stub", func() {
+			stub.Expect()
+			stub.WithArgs("hello", 1).Expect()
+
+			stub.Reset()
+
+			Expect(stub.expectations).To(BeNil())
+		})
+
+		It("verifies the expectations of each case run across a reset", func() {
+			reporter := &mockTestReporter{}
+			s := newStub(reporter, &fn, []interface{}{42, nil})
+			defer s.Restore()
+
+			s.Expect().Once()
+			s.WithArgs("hello", 1).Expect().Once()
+			fn("hello", 1)
+			Expect(s.Verify()).To(BeTrue())
+
+			s.Reset()
+			s.Expect().Twice()
+			s.WithArgs("hello", 1).Expect().Twice()
+			fn("hello", 1)
+			fn("hello", 1)
+
+			Expect(s.Verify()).To(BeTrue())
+			Expect(reporter.messages).To(BeEmpty())
+		})
+	})
+
 	Describe("Restore", func() {
 		It("overrides the function pointer with the the original pointer", func() {
 			stub.originalFunc = func(str string, num int) (int, error) {
@@ -864,6 +2131,67 @@ var _ = Describe("stub", func() {
 			Expect(err).ToNot(BeNil())
 			Expect(err.Error()).To(Equal("Ope"))
 		})
+
+		It("has no effect once the stub has been restored", func() {
+			stub.originalFunc = func(str string, num int) (int, error) {
+				return 42, errors.New("Ope")
+			}
+			stub.Restore()
+
+			replacement := func(str string, num int) (int, error) {
+				return 0, nil
+			}
+			fn = replacement
+
+			stub.Restore()
+
+			n, err := fn("hello", 2)
+			Expect(n).To(Equal(0))
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Describe("cleanup", func() {
+		It("reports only the expectations that have not been verified", func() {
+			stub.testReporter = failTestReporter
+			stub.Expect().Once()
+			_ = stub.Verify()
+			stub.Expect().Twice()
+
+			stub.cleanup()
+
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: 1 unmet expectation(s):\n\texpected func(string, int) (int, error) to be called exactly 1 time, but it was called 0 times",
+				"mocka: 1 unmet expectation(s):\n\texpected func(string, int) (int, error) to be called exactly 2 times, but it was called 0 times",
+			}))
+		})
+
+		It("waits for the asynchronous function argument calls", func() {
+			do := func(f func()) {}
+			s := newStub(GinkgoT(), &do, nil)
+			var completed int32
+
+			s.CallsArgAsync(0)
+			do(func() {
+				time.Sleep(10 * time.Millisecond)
+				atomic.StoreInt32(&completed, 1)
+			})
+			s.cleanup()
+
+			Expect(atomic.LoadInt32(&completed)).To(Equal(int32(1)))
+		})
+
+		It("restores the original function", func() {
+			stub.originalFunc = func(str string, num int) (int, error) {
+				return 42, errors.New("Ope")
+			}
+
+			stub.cleanup()
+
+			Expect(stub.restored).To(BeTrue())
+			_, err := fn("", 0)
+			Expect(err).To(MatchError("Ope"))
+		})
 	})
 
 	Describe("ExecOnCall", func() {
@@ -937,6 +2265,24 @@ var _ = Describe("stub", func() {
 
 			Expect(actual).To(Equal(matcher2))
 		})
+
+		It("ranks logical matchers by the matchers they combine", func() {
+			allOf := &CustomArguments{
+				stub:        stub,
+				argMatchers: []match.SupportedKindsMatcher{match.AllOf(match.StringPrefix("custom-"), match.Not(match.Empty())), match.Exactly(0)},
+			}
+			anyOf := &CustomArguments{
+				stub:        stub,
+				argMatchers: []match.SupportedKindsMatcher{match.AnyOf(match.Exactly("custom-"), match.Anything()), match.Exactly(0)},
+			}
+			not := &CustomArguments{
+				stub:        stub,
+				argMatchers: []match.SupportedKindsMatcher{match.Not(match.Empty()), match.Exactly(0)},
+			}
+
+			Expect(getHighestPriority([]*CustomArguments{anyOf, not, allOf}, numArguments)).To(Equal(allOf))
+			Expect(getHighestPriority([]*CustomArguments{anyOf, not}, numArguments)).To(Equal(not))
+		})
 	})
 
 	Describe("getPossible", func() {
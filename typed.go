@@ -0,0 +1,77 @@
+package mocka
+
+//go:generate go run gen_typed.go
+
+import (
+	"reflect"
+
+	"github.com/MonsantoCo/mocka/v2/match"
+)
+
+// mapMatchersToInterfaces maps a slice of matchers to interface values
+func mapMatchersToInterfaces(matchers []match.SupportedKindsMatcher) []interface{} {
+	interfaces := make([]interface{}, len(matchers))
+	for i, m := range matchers {
+		interfaces[i] = m
+	}
+
+	return interfaces
+}
+
+// mapCalls maps the calls captured by a stub to their type-safe representation
+func mapCalls[C any](calls []Call, toTyped func(Call) C) []C {
+	typedCalls := make([]C, len(calls))
+	for i, call := range calls {
+		typedCalls[i] = toTyped(call)
+	}
+
+	return typedCalls
+}
+
+// valueAt returns the value at the provided index as T. The zero value of T is
+// returned if the index is out of range or the value is nil.
+func valueAt[T any](values []interface{}, index int) T {
+	var zero T
+	if index < 0 || index >= len(values) {
+		return zero
+	}
+
+	if value, ok := values[index].(T); ok {
+		return value
+	}
+
+	return zero
+}
+
+// appendVariadic appends the variadic arguments of a type-safe stub to the
+// other arguments as interface values
+func appendVariadic[T any](arguments []interface{}, variadic []T) []interface{} {
+	for _, v := range variadic {
+		arguments = append(arguments, v)
+	}
+
+	return arguments
+}
+
+// typedReturnFunc adapts a function with the same signature as the original
+// function to compute the out parameters of the reflection based stub. A nil
+// function is returned as nil.
+func typedReturnFunc(returnFunc interface{}) func([]interface{}) []interface{} {
+	fn := reflect.ValueOf(returnFunc)
+	if fn.IsNil() {
+		return nil
+	}
+
+	return func(arguments []interface{}) []interface{} {
+		in := make([]reflect.Value, len(arguments))
+		for i, argument := range arguments {
+			in[i], _ = toAssignableValue(fn.Type().In(i), argument)
+		}
+
+		if fn.Type().IsVariadic() {
+			return mapToInterfaces(fn.CallSlice(in))
+		}
+
+		return mapToInterfaces(fn.Call(in))
+	}
+}
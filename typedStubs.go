@@ -0,0 +1,4243 @@
+// Code generated by gen_typed.go; DO NOT EDIT.
+
+package mocka
+
+import "github.com/MonsantoCo/mocka/v2/match"
+
+// returns0 provides the type-safe return value methods for
+// stubs of functions with no return values
+type returns0[F any] struct {
+	*Stub
+}
+
+// Return updates the default out parameters returned when
+// the mock function is called
+func (r returns0[F]) Return() {
+	r.Stub.Return()
+}
+
+// ReturnFunc assigns a function with the same signature as the original
+// function that computes the return values from the arguments of each call
+func (r returns0[F]) ReturnFunc(returnFunc F) {
+	r.Stub.ReturnFunc(typedReturnFunc(returnFunc))
+}
+
+// WithMatchers returns type-safe custom arguments that can change the out
+// parameters returned based on the matchers provided to this function
+func (r returns0[F]) WithMatchers(matchers ...match.SupportedKindsMatcher) *CustomArguments0[F] {
+	return &CustomArguments0[F]{r.Stub.WithArgs(mapMatchersToInterfaces(matchers)...)}
+}
+
+// OnCall returns a type-safe OnCall that allows for changing the
+// return values based on the call index.
+func (r returns0[F]) OnCall(index int) *OnCall0[F] {
+	return &OnCall0[F]{r.Stub.OnCall(index)}
+}
+
+// OnFirstCall returns a type-safe OnCall that allows for changing the
+// return values of the first call.
+func (r returns0[F]) OnFirstCall() *OnCall0[F] {
+	return r.OnCall(0)
+}
+
+// OnSecondCall returns a type-safe OnCall that allows for changing the
+// return values of the second call.
+func (r returns0[F]) OnSecondCall() *OnCall0[F] {
+	return r.OnCall(1)
+}
+
+// OnThirdCall returns a type-safe OnCall that allows for changing the
+// return values of the third call.
+func (r returns0[F]) OnThirdCall() *OnCall0[F] {
+	return r.OnCall(2)
+}
+
+// CustomArguments0 is the type-safe representation of custom
+// arguments for functions of type F with no return values
+type CustomArguments0[F any] struct {
+	*CustomArguments
+}
+
+// Return sets the return values for this set of custom arguments
+func (ca *CustomArguments0[F]) Return() {
+	ca.CustomArguments.Return()
+}
+
+// ReturnFunc assigns a function with the same signature as the original
+// function that computes the return values for this set of custom arguments
+// from the arguments of each call
+func (ca *CustomArguments0[F]) ReturnFunc(returnFunc F) {
+	ca.CustomArguments.ReturnFunc(typedReturnFunc(returnFunc))
+}
+
+// OnCall returns a type-safe OnCall that allows for changing the
+// return values based on the call index for this specific set
+// of custom arguments.
+func (ca *CustomArguments0[F]) OnCall(callIndex int) *OnCall0[F] {
+	return &OnCall0[F]{ca.CustomArguments.OnCall(callIndex)}
+}
+
+// OnFirstCall returns a type-safe OnCall that allows for changing the
+// return values of the first call for this specific set
+// of custom arguments.
+func (ca *CustomArguments0[F]) OnFirstCall() *OnCall0[F] {
+	return ca.OnCall(0)
+}
+
+// OnSecondCall returns a type-safe OnCall that allows for changing the
+// return values of the second call for this specific set
+// of custom arguments.
+func (ca *CustomArguments0[F]) OnSecondCall() *OnCall0[F] {
+	return ca.OnCall(1)
+}
+
+// OnThirdCall returns a type-safe OnCall that allows for changing the
+// return values of the third call for this specific set
+// of custom arguments.
+func (ca *CustomArguments0[F]) OnThirdCall() *OnCall0[F] {
+	return ca.OnCall(2)
+}
+
+// OnCall0 is the type-safe representation of a call
+// index for functions of type F with no return values
+type OnCall0[F any] struct {
+	*OnCall
+}
+
+// Return sets the return values for this call index
+func (c *OnCall0[F]) Return() {
+	c.OnCall.Return()
+}
+
+// ReturnFunc assigns a function with the same signature as the original
+// function that computes the return values for this call index from the
+// arguments of each call
+func (c *OnCall0[F]) ReturnFunc(returnFunc F) {
+	c.OnCall.ReturnFunc(typedReturnFunc(returnFunc))
+}
+
+// returns1 provides the type-safe return value methods for
+// stubs of functions with one return value
+type returns1[F any, R0 any] struct {
+	*Stub
+}
+
+// Return updates the default out parameters returned when
+// the mock function is called
+func (r returns1[F, R0]) Return(r0 R0) {
+	r.Stub.Return(r0)
+}
+
+// ReturnFunc assigns a function with the same signature as the original
+// function that computes the return values from the arguments of each call
+func (r returns1[F, R0]) ReturnFunc(returnFunc F) {
+	r.Stub.ReturnFunc(typedReturnFunc(returnFunc))
+}
+
+// WithMatchers returns type-safe custom arguments that can change the out
+// parameters returned based on the matchers provided to this function
+func (r returns1[F, R0]) WithMatchers(matchers ...match.SupportedKindsMatcher) *CustomArguments1[F, R0] {
+	return &CustomArguments1[F, R0]{r.Stub.WithArgs(mapMatchersToInterfaces(matchers)...)}
+}
+
+// OnCall returns a type-safe OnCall that allows for changing the
+// return values based on the call index.
+func (r returns1[F, R0]) OnCall(index int) *OnCall1[F, R0] {
+	return &OnCall1[F, R0]{r.Stub.OnCall(index)}
+}
+
+// OnFirstCall returns a type-safe OnCall that allows for changing the
+// return values of the first call.
+func (r returns1[F, R0]) OnFirstCall() *OnCall1[F, R0] {
+	return r.OnCall(0)
+}
+
+// OnSecondCall returns a type-safe OnCall that allows for changing the
+// return values of the second call.
+func (r returns1[F, R0]) OnSecondCall() *OnCall1[F, R0] {
+	return r.OnCall(1)
+}
+
+// OnThirdCall returns a type-safe OnCall that allows for changing the
+// return values of the third call.
+func (r returns1[F, R0]) OnThirdCall() *OnCall1[F, R0] {
+	return r.OnCall(2)
+}
+
+// CustomArguments1 is the type-safe representation of custom
+// arguments for functions of type F with one return value
+type CustomArguments1[F any, R0 any] struct {
+	*CustomArguments
+}
+
+// Return sets the return values for this set of custom arguments
+func (ca *CustomArguments1[F, R0]) Return(r0 R0) {
+	ca.CustomArguments.Return(r0)
+}
+
+// ReturnFunc assigns a function with the same signature as the original
+// function that computes the return values for this set of custom arguments
+// from the arguments of each call
+func (ca *CustomArguments1[F, R0]) ReturnFunc(returnFunc F) {
+	ca.CustomArguments.ReturnFunc(typedReturnFunc(returnFunc))
+}
+
+// OnCall returns a type-safe OnCall that allows for changing the
+// return values based on the call index for this specific set
+// of custom arguments.
+func (ca *CustomArguments1[F, R0]) OnCall(callIndex int) *OnCall1[F, R0] {
+	return &OnCall1[F, R0]{ca.CustomArguments.OnCall(callIndex)}
+}
+
+// OnFirstCall returns a type-safe OnCall that allows for changing the
+// return values of the first call for this specific set
+// of custom arguments.
+func (ca *CustomArguments1[F, R0]) OnFirstCall() *OnCall1[F, R0] {
+	return ca.OnCall(0)
+}
+
+// OnSecondCall returns a type-safe OnCall that allows for changing the
+// return values of the second call for this specific set
+// of custom arguments.
+func (ca *CustomArguments1[F, R0]) OnSecondCall() *OnCall1[F, R0] {
+	return ca.OnCall(1)
+}
+
+// OnThirdCall returns a type-safe OnCall that allows for changing the
+// return values of the third call for this specific set
+// of custom arguments.
+func (ca *CustomArguments1[F, R0]) OnThirdCall() *OnCall1[F, R0] {
+	return ca.OnCall(2)
+}
+
+// OnCall1 is the type-safe representation of a call
+// index for functions of type F with one return value
+type OnCall1[F any, R0 any] struct {
+	*OnCall
+}
+
+// Return sets the return values for this call index
+func (c *OnCall1[F, R0]) Return(r0 R0) {
+	c.OnCall.Return(r0)
+}
+
+// ReturnFunc assigns a function with the same signature as the original
+// function that computes the return values for this call index from the
+// arguments of each call
+func (c *OnCall1[F, R0]) ReturnFunc(returnFunc F) {
+	c.OnCall.ReturnFunc(typedReturnFunc(returnFunc))
+}
+
+// returns2 provides the type-safe return value methods for
+// stubs of functions with two return values
+type returns2[F any, R0, R1 any] struct {
+	*Stub
+}
+
+// Return updates the default out parameters returned when
+// the mock function is called
+func (r returns2[F, R0, R1]) Return(r0 R0, r1 R1) {
+	r.Stub.Return(r0, r1)
+}
+
+// ReturnFunc assigns a function with the same signature as the original
+// function that computes the return values from the arguments of each call
+func (r returns2[F, R0, R1]) ReturnFunc(returnFunc F) {
+	r.Stub.ReturnFunc(typedReturnFunc(returnFunc))
+}
+
+// WithMatchers returns type-safe custom arguments that can change the out
+// parameters returned based on the matchers provided to this function
+func (r returns2[F, R0, R1]) WithMatchers(matchers ...match.SupportedKindsMatcher) *CustomArguments2[F, R0, R1] {
+	return &CustomArguments2[F, R0, R1]{r.Stub.WithArgs(mapMatchersToInterfaces(matchers)...)}
+}
+
+// OnCall returns a type-safe OnCall that allows for changing the
+// return values based on the call index.
+func (r returns2[F, R0, R1]) OnCall(index int) *OnCall2[F, R0, R1] {
+	return &OnCall2[F, R0, R1]{r.Stub.OnCall(index)}
+}
+
+// OnFirstCall returns a type-safe OnCall that allows for changing the
+// return values of the first call.
+func (r returns2[F, R0, R1]) OnFirstCall() *OnCall2[F, R0, R1] {
+	return r.OnCall(0)
+}
+
+// OnSecondCall returns a type-safe OnCall that allows for changing the
+// return values of the second call.
+func (r returns2[F, R0, R1]) OnSecondCall() *OnCall2[F, R0, R1] {
+	return r.OnCall(1)
+}
+
+// OnThirdCall returns a type-safe OnCall that allows for changing the
+// return values of the third call.
+func (r returns2[F, R0, R1]) OnThirdCall() *OnCall2[F, R0, R1] {
+	return r.OnCall(2)
+}
+
+// CustomArguments2 is the type-safe representation of custom
+// arguments for functions of type F with two return values
+type CustomArguments2[F any, R0, R1 any] struct {
+	*CustomArguments
+}
+
+// Return sets the return values for this set of custom arguments
+func (ca *CustomArguments2[F, R0, R1]) Return(r0 R0, r1 R1) {
+	ca.CustomArguments.Return(r0, r1)
+}
+
+// ReturnFunc assigns a function with the same signature as the original
+// function that computes the return values for this set of custom arguments
+// from the arguments of each call
+func (ca *CustomArguments2[F, R0, R1]) ReturnFunc(returnFunc F) {
+	ca.CustomArguments.ReturnFunc(typedReturnFunc(returnFunc))
+}
+
+// OnCall returns a type-safe OnCall that allows for changing the
+// return values based on the call index for this specific set
+// of custom arguments.
+func (ca *CustomArguments2[F, R0, R1]) OnCall(callIndex int) *OnCall2[F, R0, R1] {
+	return &OnCall2[F, R0, R1]{ca.CustomArguments.OnCall(callIndex)}
+}
+
+// OnFirstCall returns a type-safe OnCall that allows for changing the
+// return values of the first call for this specific set
+// of custom arguments.
+func (ca *CustomArguments2[F, R0, R1]) OnFirstCall() *OnCall2[F, R0, R1] {
+	return ca.OnCall(0)
+}
+
+// OnSecondCall returns a type-safe OnCall that allows for changing the
+// return values of the second call for this specific set
+// of custom arguments.
+func (ca *CustomArguments2[F, R0, R1]) OnSecondCall() *OnCall2[F, R0, R1] {
+	return ca.OnCall(1)
+}
+
+// OnThirdCall returns a type-safe OnCall that allows for changing the
+// return values of the third call for this specific set
+// of custom arguments.
+func (ca *CustomArguments2[F, R0, R1]) OnThirdCall() *OnCall2[F, R0, R1] {
+	return ca.OnCall(2)
+}
+
+// OnCall2 is the type-safe representation of a call
+// index for functions of type F with two return values
+type OnCall2[F any, R0, R1 any] struct {
+	*OnCall
+}
+
+// Return sets the return values for this call index
+func (c *OnCall2[F, R0, R1]) Return(r0 R0, r1 R1) {
+	c.OnCall.Return(r0, r1)
+}
+
+// ReturnFunc assigns a function with the same signature as the original
+// function that computes the return values for this call index from the
+// arguments of each call
+func (c *OnCall2[F, R0, R1]) ReturnFunc(returnFunc F) {
+	c.OnCall.ReturnFunc(typedReturnFunc(returnFunc))
+}
+
+// returns3 provides the type-safe return value methods for
+// stubs of functions with three return values
+type returns3[F any, R0, R1, R2 any] struct {
+	*Stub
+}
+
+// Return updates the default out parameters returned when
+// the mock function is called
+func (r returns3[F, R0, R1, R2]) Return(r0 R0, r1 R1, r2 R2) {
+	r.Stub.Return(r0, r1, r2)
+}
+
+// ReturnFunc assigns a function with the same signature as the original
+// function that computes the return values from the arguments of each call
+func (r returns3[F, R0, R1, R2]) ReturnFunc(returnFunc F) {
+	r.Stub.ReturnFunc(typedReturnFunc(returnFunc))
+}
+
+// WithMatchers returns type-safe custom arguments that can change the out
+// parameters returned based on the matchers provided to this function
+func (r returns3[F, R0, R1, R2]) WithMatchers(matchers ...match.SupportedKindsMatcher) *CustomArguments3[F, R0, R1, R2] {
+	return &CustomArguments3[F, R0, R1, R2]{r.Stub.WithArgs(mapMatchersToInterfaces(matchers)...)}
+}
+
+// OnCall returns a type-safe OnCall that allows for changing the
+// return values based on the call index.
+func (r returns3[F, R0, R1, R2]) OnCall(index int) *OnCall3[F, R0, R1, R2] {
+	return &OnCall3[F, R0, R1, R2]{r.Stub.OnCall(index)}
+}
+
+// OnFirstCall returns a type-safe OnCall that allows for changing the
+// return values of the first call.
+func (r returns3[F, R0, R1, R2]) OnFirstCall() *OnCall3[F, R0, R1, R2] {
+	return r.OnCall(0)
+}
+
+// OnSecondCall returns a type-safe OnCall that allows for changing the
+// return values of the second call.
+func (r returns3[F, R0, R1, R2]) OnSecondCall() *OnCall3[F, R0, R1, R2] {
+	return r.OnCall(1)
+}
+
+// OnThirdCall returns a type-safe OnCall that allows for changing the
+// return values of the third call.
+func (r returns3[F, R0, R1, R2]) OnThirdCall() *OnCall3[F, R0, R1, R2] {
+	return r.OnCall(2)
+}
+
+// CustomArguments3 is the type-safe representation of custom
+// arguments for functions of type F with three return values
+type CustomArguments3[F any, R0, R1, R2 any] struct {
+	*CustomArguments
+}
+
+// Return sets the return values for this set of custom arguments
+func (ca *CustomArguments3[F, R0, R1, R2]) Return(r0 R0, r1 R1, r2 R2) {
+	ca.CustomArguments.Return(r0, r1, r2)
+}
+
+// ReturnFunc assigns a function with the same signature as the original
+// function that computes the return values for this set of custom arguments
+// from the arguments of each call
+func (ca *CustomArguments3[F, R0, R1, R2]) ReturnFunc(returnFunc F) {
+	ca.CustomArguments.ReturnFunc(typedReturnFunc(returnFunc))
+}
+
+// OnCall returns a type-safe OnCall that allows for changing the
+// return values based on the call index for this specific set
+// of custom arguments.
+func (ca *CustomArguments3[F, R0, R1, R2]) OnCall(callIndex int) *OnCall3[F, R0, R1, R2] {
+	return &OnCall3[F, R0, R1, R2]{ca.CustomArguments.OnCall(callIndex)}
+}
+
+// OnFirstCall returns a type-safe OnCall that allows for changing the
+// return values of the first call for this specific set
+// of custom arguments.
+func (ca *CustomArguments3[F, R0, R1, R2]) OnFirstCall() *OnCall3[F, R0, R1, R2] {
+	return ca.OnCall(0)
+}
+
+// OnSecondCall returns a type-safe OnCall that allows for changing the
+// return values of the second call for this specific set
+// of custom arguments.
+func (ca *CustomArguments3[F, R0, R1, R2]) OnSecondCall() *OnCall3[F, R0, R1, R2] {
+	return ca.OnCall(1)
+}
+
+// OnThirdCall returns a type-safe OnCall that allows for changing the
+// return values of the third call for this specific set
+// of custom arguments.
+func (ca *CustomArguments3[F, R0, R1, R2]) OnThirdCall() *OnCall3[F, R0, R1, R2] {
+	return ca.OnCall(2)
+}
+
+// OnCall3 is the type-safe representation of a call
+// index for functions of type F with three return values
+type OnCall3[F any, R0, R1, R2 any] struct {
+	*OnCall
+}
+
+// Return sets the return values for this call index
+func (c *OnCall3[F, R0, R1, R2]) Return(r0 R0, r1 R1, r2 R2) {
+	c.OnCall.Return(r0, r1, r2)
+}
+
+// ReturnFunc assigns a function with the same signature as the original
+// function that computes the return values for this call index from the
+// arguments of each call
+func (c *OnCall3[F, R0, R1, R2]) ReturnFunc(returnFunc F) {
+	c.OnCall.ReturnFunc(typedReturnFunc(returnFunc))
+}
+
+// Stub0x0 is a type-safe stub for functions with no arguments and no return values.
+type Stub0x0 struct {
+	returns0[func()]
+}
+
+// Function0x0 replaces the provided function, which has
+// no arguments and no return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function0x0[F ~func()](testReporter TestReporter, originalFuncPtr *F) *Stub0x0 {
+	return newStub0x0(Function(testReporter, originalFuncPtr))
+}
+
+// newStub0x0 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub0x0(stub *Stub) *Stub0x0 {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub0x0{returns0[func()]{stub}}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub0x0) GetCalls() []Call0x0 {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call0x0 { return Call0x0{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub0x0) GetCall(callIndex int) Call0x0 {
+	return Call0x0{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub0x0) GetFirstCall() Call0x0 {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub0x0) GetSecondCall() Call0x0 {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub0x0) GetThirdCall() Call0x0 {
+	return s.GetCall(2)
+}
+
+// Call0x0 is the type-safe representation of a call to a function
+// with no arguments and no return values
+type Call0x0 struct {
+	Call
+}
+
+// Stub0x1 is a type-safe stub for functions with no arguments and one return value.
+type Stub0x1[R0 any] struct {
+	returns1[func() R0, R0]
+}
+
+// Function0x1 replaces the provided function, which has
+// no arguments and one return value, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function0x1[F ~func() R0, R0 any](testReporter TestReporter, originalFuncPtr *F) *Stub0x1[R0] {
+	return newStub0x1[R0](Function(testReporter, originalFuncPtr))
+}
+
+// newStub0x1 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub0x1[R0 any](stub *Stub) *Stub0x1[R0] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub0x1[R0]{returns1[func() R0, R0]{stub}}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub0x1[R0]) GetCalls() []Call0x1[R0] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call0x1[R0] { return Call0x1[R0]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub0x1[R0]) GetCall(callIndex int) Call0x1[R0] {
+	return Call0x1[R0]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub0x1[R0]) GetFirstCall() Call0x1[R0] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub0x1[R0]) GetSecondCall() Call0x1[R0] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub0x1[R0]) GetThirdCall() Call0x1[R0] {
+	return s.GetCall(2)
+}
+
+// Call0x1 is the type-safe representation of a call to a function
+// with no arguments and one return value
+type Call0x1[R0 any] struct {
+	Call
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call0x1[R0]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// Stub0x2 is a type-safe stub for functions with no arguments and two return values.
+type Stub0x2[R0, R1 any] struct {
+	returns2[func() (R0, R1), R0, R1]
+}
+
+// Function0x2 replaces the provided function, which has
+// no arguments and two return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function0x2[F ~func() (R0, R1), R0, R1 any](testReporter TestReporter, originalFuncPtr *F) *Stub0x2[R0, R1] {
+	return newStub0x2[R0, R1](Function(testReporter, originalFuncPtr))
+}
+
+// newStub0x2 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub0x2[R0, R1 any](stub *Stub) *Stub0x2[R0, R1] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub0x2[R0, R1]{returns2[func() (R0, R1), R0, R1]{stub}}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub0x2[R0, R1]) GetCalls() []Call0x2[R0, R1] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call0x2[R0, R1] { return Call0x2[R0, R1]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub0x2[R0, R1]) GetCall(callIndex int) Call0x2[R0, R1] {
+	return Call0x2[R0, R1]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub0x2[R0, R1]) GetFirstCall() Call0x2[R0, R1] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub0x2[R0, R1]) GetSecondCall() Call0x2[R0, R1] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub0x2[R0, R1]) GetThirdCall() Call0x2[R0, R1] {
+	return s.GetCall(2)
+}
+
+// Call0x2 is the type-safe representation of a call to a function
+// with no arguments and two return values
+type Call0x2[R0, R1 any] struct {
+	Call
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call0x2[R0, R1]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// ReturnValue1 returns return value 1 of the stubbed implementation
+func (c Call0x2[R0, R1]) ReturnValue1() R1 {
+	return valueAt[R1](c.out, 1)
+}
+
+// Stub0x3 is a type-safe stub for functions with no arguments and three return values.
+type Stub0x3[R0, R1, R2 any] struct {
+	returns3[func() (R0, R1, R2), R0, R1, R2]
+}
+
+// Function0x3 replaces the provided function, which has
+// no arguments and three return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function0x3[F ~func() (R0, R1, R2), R0, R1, R2 any](testReporter TestReporter, originalFuncPtr *F) *Stub0x3[R0, R1, R2] {
+	return newStub0x3[R0, R1, R2](Function(testReporter, originalFuncPtr))
+}
+
+// newStub0x3 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub0x3[R0, R1, R2 any](stub *Stub) *Stub0x3[R0, R1, R2] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub0x3[R0, R1, R2]{returns3[func() (R0, R1, R2), R0, R1, R2]{stub}}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub0x3[R0, R1, R2]) GetCalls() []Call0x3[R0, R1, R2] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call0x3[R0, R1, R2] { return Call0x3[R0, R1, R2]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub0x3[R0, R1, R2]) GetCall(callIndex int) Call0x3[R0, R1, R2] {
+	return Call0x3[R0, R1, R2]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub0x3[R0, R1, R2]) GetFirstCall() Call0x3[R0, R1, R2] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub0x3[R0, R1, R2]) GetSecondCall() Call0x3[R0, R1, R2] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub0x3[R0, R1, R2]) GetThirdCall() Call0x3[R0, R1, R2] {
+	return s.GetCall(2)
+}
+
+// Call0x3 is the type-safe representation of a call to a function
+// with no arguments and three return values
+type Call0x3[R0, R1, R2 any] struct {
+	Call
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call0x3[R0, R1, R2]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// ReturnValue1 returns return value 1 of the stubbed implementation
+func (c Call0x3[R0, R1, R2]) ReturnValue1() R1 {
+	return valueAt[R1](c.out, 1)
+}
+
+// ReturnValue2 returns return value 2 of the stubbed implementation
+func (c Call0x3[R0, R1, R2]) ReturnValue2() R2 {
+	return valueAt[R2](c.out, 2)
+}
+
+// Stub1x0 is a type-safe stub for functions with one argument and no return values.
+type Stub1x0[A0 any] struct {
+	returns0[func(A0)]
+}
+
+// Function1x0 replaces the provided function, which has
+// one argument and no return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function1x0[F ~func(A0), A0 any](testReporter TestReporter, originalFuncPtr *F) *Stub1x0[A0] {
+	return newStub1x0[A0](Function(testReporter, originalFuncPtr))
+}
+
+// newStub1x0 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub1x0[A0 any](stub *Stub) *Stub1x0[A0] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub1x0[A0]{returns0[func(A0)]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub1x0[A0]) WithArgs(a0 A0) *CustomArguments0[func(A0)] {
+	return &CustomArguments0[func(A0)]{s.Stub.WithArgs(a0)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub1x0[A0]) GetCalls() []Call1x0[A0] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call1x0[A0] { return Call1x0[A0]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub1x0[A0]) GetCall(callIndex int) Call1x0[A0] {
+	return Call1x0[A0]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub1x0[A0]) GetFirstCall() Call1x0[A0] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub1x0[A0]) GetSecondCall() Call1x0[A0] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub1x0[A0]) GetThirdCall() Call1x0[A0] {
+	return s.GetCall(2)
+}
+
+// Call1x0 is the type-safe representation of a call to a function
+// with one argument and no return values
+type Call1x0[A0 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call1x0[A0]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Stub1x1 is a type-safe stub for functions with one argument and one return value.
+type Stub1x1[A0, R0 any] struct {
+	returns1[func(A0) R0, R0]
+}
+
+// Function1x1 replaces the provided function, which has
+// one argument and one return value, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function1x1[F ~func(A0) R0, A0, R0 any](testReporter TestReporter, originalFuncPtr *F) *Stub1x1[A0, R0] {
+	return newStub1x1[A0, R0](Function(testReporter, originalFuncPtr))
+}
+
+// newStub1x1 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub1x1[A0, R0 any](stub *Stub) *Stub1x1[A0, R0] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub1x1[A0, R0]{returns1[func(A0) R0, R0]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub1x1[A0, R0]) WithArgs(a0 A0) *CustomArguments1[func(A0) R0, R0] {
+	return &CustomArguments1[func(A0) R0, R0]{s.Stub.WithArgs(a0)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub1x1[A0, R0]) GetCalls() []Call1x1[A0, R0] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call1x1[A0, R0] { return Call1x1[A0, R0]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub1x1[A0, R0]) GetCall(callIndex int) Call1x1[A0, R0] {
+	return Call1x1[A0, R0]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub1x1[A0, R0]) GetFirstCall() Call1x1[A0, R0] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub1x1[A0, R0]) GetSecondCall() Call1x1[A0, R0] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub1x1[A0, R0]) GetThirdCall() Call1x1[A0, R0] {
+	return s.GetCall(2)
+}
+
+// Call1x1 is the type-safe representation of a call to a function
+// with one argument and one return value
+type Call1x1[A0, R0 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call1x1[A0, R0]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call1x1[A0, R0]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// Stub1x2 is a type-safe stub for functions with one argument and two return values.
+type Stub1x2[A0, R0, R1 any] struct {
+	returns2[func(A0) (R0, R1), R0, R1]
+}
+
+// Function1x2 replaces the provided function, which has
+// one argument and two return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function1x2[F ~func(A0) (R0, R1), A0, R0, R1 any](testReporter TestReporter, originalFuncPtr *F) *Stub1x2[A0, R0, R1] {
+	return newStub1x2[A0, R0, R1](Function(testReporter, originalFuncPtr))
+}
+
+// newStub1x2 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub1x2[A0, R0, R1 any](stub *Stub) *Stub1x2[A0, R0, R1] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub1x2[A0, R0, R1]{returns2[func(A0) (R0, R1), R0, R1]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub1x2[A0, R0, R1]) WithArgs(a0 A0) *CustomArguments2[func(A0) (R0, R1), R0, R1] {
+	return &CustomArguments2[func(A0) (R0, R1), R0, R1]{s.Stub.WithArgs(a0)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub1x2[A0, R0, R1]) GetCalls() []Call1x2[A0, R0, R1] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call1x2[A0, R0, R1] { return Call1x2[A0, R0, R1]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub1x2[A0, R0, R1]) GetCall(callIndex int) Call1x2[A0, R0, R1] {
+	return Call1x2[A0, R0, R1]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub1x2[A0, R0, R1]) GetFirstCall() Call1x2[A0, R0, R1] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub1x2[A0, R0, R1]) GetSecondCall() Call1x2[A0, R0, R1] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub1x2[A0, R0, R1]) GetThirdCall() Call1x2[A0, R0, R1] {
+	return s.GetCall(2)
+}
+
+// Call1x2 is the type-safe representation of a call to a function
+// with one argument and two return values
+type Call1x2[A0, R0, R1 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call1x2[A0, R0, R1]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call1x2[A0, R0, R1]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// ReturnValue1 returns return value 1 of the stubbed implementation
+func (c Call1x2[A0, R0, R1]) ReturnValue1() R1 {
+	return valueAt[R1](c.out, 1)
+}
+
+// Stub1x3 is a type-safe stub for functions with one argument and three return values.
+type Stub1x3[A0, R0, R1, R2 any] struct {
+	returns3[func(A0) (R0, R1, R2), R0, R1, R2]
+}
+
+// Function1x3 replaces the provided function, which has
+// one argument and three return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function1x3[F ~func(A0) (R0, R1, R2), A0, R0, R1, R2 any](testReporter TestReporter, originalFuncPtr *F) *Stub1x3[A0, R0, R1, R2] {
+	return newStub1x3[A0, R0, R1, R2](Function(testReporter, originalFuncPtr))
+}
+
+// newStub1x3 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub1x3[A0, R0, R1, R2 any](stub *Stub) *Stub1x3[A0, R0, R1, R2] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub1x3[A0, R0, R1, R2]{returns3[func(A0) (R0, R1, R2), R0, R1, R2]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub1x3[A0, R0, R1, R2]) WithArgs(a0 A0) *CustomArguments3[func(A0) (R0, R1, R2), R0, R1, R2] {
+	return &CustomArguments3[func(A0) (R0, R1, R2), R0, R1, R2]{s.Stub.WithArgs(a0)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub1x3[A0, R0, R1, R2]) GetCalls() []Call1x3[A0, R0, R1, R2] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call1x3[A0, R0, R1, R2] { return Call1x3[A0, R0, R1, R2]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub1x3[A0, R0, R1, R2]) GetCall(callIndex int) Call1x3[A0, R0, R1, R2] {
+	return Call1x3[A0, R0, R1, R2]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub1x3[A0, R0, R1, R2]) GetFirstCall() Call1x3[A0, R0, R1, R2] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub1x3[A0, R0, R1, R2]) GetSecondCall() Call1x3[A0, R0, R1, R2] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub1x3[A0, R0, R1, R2]) GetThirdCall() Call1x3[A0, R0, R1, R2] {
+	return s.GetCall(2)
+}
+
+// Call1x3 is the type-safe representation of a call to a function
+// with one argument and three return values
+type Call1x3[A0, R0, R1, R2 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call1x3[A0, R0, R1, R2]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call1x3[A0, R0, R1, R2]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// ReturnValue1 returns return value 1 of the stubbed implementation
+func (c Call1x3[A0, R0, R1, R2]) ReturnValue1() R1 {
+	return valueAt[R1](c.out, 1)
+}
+
+// ReturnValue2 returns return value 2 of the stubbed implementation
+func (c Call1x3[A0, R0, R1, R2]) ReturnValue2() R2 {
+	return valueAt[R2](c.out, 2)
+}
+
+// Stub1Vx0 is a type-safe stub for functions with one variadic argument and no return values.
+type Stub1Vx0[A0 any] struct {
+	returns0[func(...A0)]
+}
+
+// Function1Vx0 replaces the provided function, which has
+// one variadic argument and no return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function1Vx0[F ~func(...A0), A0 any](testReporter TestReporter, originalFuncPtr *F) *Stub1Vx0[A0] {
+	return newStub1Vx0[A0](Function(testReporter, originalFuncPtr))
+}
+
+// newStub1Vx0 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub1Vx0[A0 any](stub *Stub) *Stub1Vx0[A0] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub1Vx0[A0]{returns0[func(...A0)]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub1Vx0[A0]) WithArgs(a0 ...A0) *CustomArguments0[func(...A0)] {
+	return &CustomArguments0[func(...A0)]{s.Stub.WithArgs(appendVariadic([]interface{}{}, a0)...)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub1Vx0[A0]) GetCalls() []Call1Vx0[A0] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call1Vx0[A0] { return Call1Vx0[A0]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub1Vx0[A0]) GetCall(callIndex int) Call1Vx0[A0] {
+	return Call1Vx0[A0]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub1Vx0[A0]) GetFirstCall() Call1Vx0[A0] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub1Vx0[A0]) GetSecondCall() Call1Vx0[A0] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub1Vx0[A0]) GetThirdCall() Call1Vx0[A0] {
+	return s.GetCall(2)
+}
+
+// Call1Vx0 is the type-safe representation of a call to a function
+// with one variadic argument and no return values
+type Call1Vx0[A0 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with, the
+// variadic arguments as a slice
+func (c Call1Vx0[A0]) Arg0() []A0 {
+	return valueAt[[]A0](c.args, 0)
+}
+
+// Stub1Vx1 is a type-safe stub for functions with one variadic argument and one return value.
+type Stub1Vx1[A0, R0 any] struct {
+	returns1[func(...A0) R0, R0]
+}
+
+// Function1Vx1 replaces the provided function, which has
+// one variadic argument and one return value, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function1Vx1[F ~func(...A0) R0, A0, R0 any](testReporter TestReporter, originalFuncPtr *F) *Stub1Vx1[A0, R0] {
+	return newStub1Vx1[A0, R0](Function(testReporter, originalFuncPtr))
+}
+
+// newStub1Vx1 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub1Vx1[A0, R0 any](stub *Stub) *Stub1Vx1[A0, R0] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub1Vx1[A0, R0]{returns1[func(...A0) R0, R0]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub1Vx1[A0, R0]) WithArgs(a0 ...A0) *CustomArguments1[func(...A0) R0, R0] {
+	return &CustomArguments1[func(...A0) R0, R0]{s.Stub.WithArgs(appendVariadic([]interface{}{}, a0)...)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub1Vx1[A0, R0]) GetCalls() []Call1Vx1[A0, R0] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call1Vx1[A0, R0] { return Call1Vx1[A0, R0]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub1Vx1[A0, R0]) GetCall(callIndex int) Call1Vx1[A0, R0] {
+	return Call1Vx1[A0, R0]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub1Vx1[A0, R0]) GetFirstCall() Call1Vx1[A0, R0] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub1Vx1[A0, R0]) GetSecondCall() Call1Vx1[A0, R0] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub1Vx1[A0, R0]) GetThirdCall() Call1Vx1[A0, R0] {
+	return s.GetCall(2)
+}
+
+// Call1Vx1 is the type-safe representation of a call to a function
+// with one variadic argument and one return value
+type Call1Vx1[A0, R0 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with, the
+// variadic arguments as a slice
+func (c Call1Vx1[A0, R0]) Arg0() []A0 {
+	return valueAt[[]A0](c.args, 0)
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call1Vx1[A0, R0]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// Stub1Vx2 is a type-safe stub for functions with one variadic argument and two return values.
+type Stub1Vx2[A0, R0, R1 any] struct {
+	returns2[func(...A0) (R0, R1), R0, R1]
+}
+
+// Function1Vx2 replaces the provided function, which has
+// one variadic argument and two return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function1Vx2[F ~func(...A0) (R0, R1), A0, R0, R1 any](testReporter TestReporter, originalFuncPtr *F) *Stub1Vx2[A0, R0, R1] {
+	return newStub1Vx2[A0, R0, R1](Function(testReporter, originalFuncPtr))
+}
+
+// newStub1Vx2 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub1Vx2[A0, R0, R1 any](stub *Stub) *Stub1Vx2[A0, R0, R1] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub1Vx2[A0, R0, R1]{returns2[func(...A0) (R0, R1), R0, R1]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub1Vx2[A0, R0, R1]) WithArgs(a0 ...A0) *CustomArguments2[func(...A0) (R0, R1), R0, R1] {
+	return &CustomArguments2[func(...A0) (R0, R1), R0, R1]{s.Stub.WithArgs(appendVariadic([]interface{}{}, a0)...)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub1Vx2[A0, R0, R1]) GetCalls() []Call1Vx2[A0, R0, R1] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call1Vx2[A0, R0, R1] { return Call1Vx2[A0, R0, R1]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub1Vx2[A0, R0, R1]) GetCall(callIndex int) Call1Vx2[A0, R0, R1] {
+	return Call1Vx2[A0, R0, R1]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub1Vx2[A0, R0, R1]) GetFirstCall() Call1Vx2[A0, R0, R1] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub1Vx2[A0, R0, R1]) GetSecondCall() Call1Vx2[A0, R0, R1] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub1Vx2[A0, R0, R1]) GetThirdCall() Call1Vx2[A0, R0, R1] {
+	return s.GetCall(2)
+}
+
+// Call1Vx2 is the type-safe representation of a call to a function
+// with one variadic argument and two return values
+type Call1Vx2[A0, R0, R1 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with, the
+// variadic arguments as a slice
+func (c Call1Vx2[A0, R0, R1]) Arg0() []A0 {
+	return valueAt[[]A0](c.args, 0)
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call1Vx2[A0, R0, R1]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// ReturnValue1 returns return value 1 of the stubbed implementation
+func (c Call1Vx2[A0, R0, R1]) ReturnValue1() R1 {
+	return valueAt[R1](c.out, 1)
+}
+
+// Stub1Vx3 is a type-safe stub for functions with one variadic argument and three return values.
+type Stub1Vx3[A0, R0, R1, R2 any] struct {
+	returns3[func(...A0) (R0, R1, R2), R0, R1, R2]
+}
+
+// Function1Vx3 replaces the provided function, which has
+// one variadic argument and three return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function1Vx3[F ~func(...A0) (R0, R1, R2), A0, R0, R1, R2 any](testReporter TestReporter, originalFuncPtr *F) *Stub1Vx3[A0, R0, R1, R2] {
+	return newStub1Vx3[A0, R0, R1, R2](Function(testReporter, originalFuncPtr))
+}
+
+// newStub1Vx3 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub1Vx3[A0, R0, R1, R2 any](stub *Stub) *Stub1Vx3[A0, R0, R1, R2] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub1Vx3[A0, R0, R1, R2]{returns3[func(...A0) (R0, R1, R2), R0, R1, R2]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub1Vx3[A0, R0, R1, R2]) WithArgs(a0 ...A0) *CustomArguments3[func(...A0) (R0, R1, R2), R0, R1, R2] {
+	return &CustomArguments3[func(...A0) (R0, R1, R2), R0, R1, R2]{s.Stub.WithArgs(appendVariadic([]interface{}{}, a0)...)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub1Vx3[A0, R0, R1, R2]) GetCalls() []Call1Vx3[A0, R0, R1, R2] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call1Vx3[A0, R0, R1, R2] { return Call1Vx3[A0, R0, R1, R2]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub1Vx3[A0, R0, R1, R2]) GetCall(callIndex int) Call1Vx3[A0, R0, R1, R2] {
+	return Call1Vx3[A0, R0, R1, R2]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub1Vx3[A0, R0, R1, R2]) GetFirstCall() Call1Vx3[A0, R0, R1, R2] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub1Vx3[A0, R0, R1, R2]) GetSecondCall() Call1Vx3[A0, R0, R1, R2] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub1Vx3[A0, R0, R1, R2]) GetThirdCall() Call1Vx3[A0, R0, R1, R2] {
+	return s.GetCall(2)
+}
+
+// Call1Vx3 is the type-safe representation of a call to a function
+// with one variadic argument and three return values
+type Call1Vx3[A0, R0, R1, R2 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with, the
+// variadic arguments as a slice
+func (c Call1Vx3[A0, R0, R1, R2]) Arg0() []A0 {
+	return valueAt[[]A0](c.args, 0)
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call1Vx3[A0, R0, R1, R2]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// ReturnValue1 returns return value 1 of the stubbed implementation
+func (c Call1Vx3[A0, R0, R1, R2]) ReturnValue1() R1 {
+	return valueAt[R1](c.out, 1)
+}
+
+// ReturnValue2 returns return value 2 of the stubbed implementation
+func (c Call1Vx3[A0, R0, R1, R2]) ReturnValue2() R2 {
+	return valueAt[R2](c.out, 2)
+}
+
+// Stub2x0 is a type-safe stub for functions with two arguments and no return values.
+type Stub2x0[A0, A1 any] struct {
+	returns0[func(A0, A1)]
+}
+
+// Function2x0 replaces the provided function, which has
+// two arguments and no return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function2x0[F ~func(A0, A1), A0, A1 any](testReporter TestReporter, originalFuncPtr *F) *Stub2x0[A0, A1] {
+	return newStub2x0[A0, A1](Function(testReporter, originalFuncPtr))
+}
+
+// newStub2x0 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub2x0[A0, A1 any](stub *Stub) *Stub2x0[A0, A1] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub2x0[A0, A1]{returns0[func(A0, A1)]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub2x0[A0, A1]) WithArgs(a0 A0, a1 A1) *CustomArguments0[func(A0, A1)] {
+	return &CustomArguments0[func(A0, A1)]{s.Stub.WithArgs(a0, a1)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub2x0[A0, A1]) GetCalls() []Call2x0[A0, A1] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call2x0[A0, A1] { return Call2x0[A0, A1]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub2x0[A0, A1]) GetCall(callIndex int) Call2x0[A0, A1] {
+	return Call2x0[A0, A1]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub2x0[A0, A1]) GetFirstCall() Call2x0[A0, A1] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub2x0[A0, A1]) GetSecondCall() Call2x0[A0, A1] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub2x0[A0, A1]) GetThirdCall() Call2x0[A0, A1] {
+	return s.GetCall(2)
+}
+
+// Call2x0 is the type-safe representation of a call to a function
+// with two arguments and no return values
+type Call2x0[A0, A1 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call2x0[A0, A1]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with
+func (c Call2x0[A0, A1]) Arg1() A1 {
+	return valueAt[A1](c.args, 1)
+}
+
+// Stub2x1 is a type-safe stub for functions with two arguments and one return value.
+type Stub2x1[A0, A1, R0 any] struct {
+	returns1[func(A0, A1) R0, R0]
+}
+
+// Function2x1 replaces the provided function, which has
+// two arguments and one return value, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function2x1[F ~func(A0, A1) R0, A0, A1, R0 any](testReporter TestReporter, originalFuncPtr *F) *Stub2x1[A0, A1, R0] {
+	return newStub2x1[A0, A1, R0](Function(testReporter, originalFuncPtr))
+}
+
+// newStub2x1 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub2x1[A0, A1, R0 any](stub *Stub) *Stub2x1[A0, A1, R0] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub2x1[A0, A1, R0]{returns1[func(A0, A1) R0, R0]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub2x1[A0, A1, R0]) WithArgs(a0 A0, a1 A1) *CustomArguments1[func(A0, A1) R0, R0] {
+	return &CustomArguments1[func(A0, A1) R0, R0]{s.Stub.WithArgs(a0, a1)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub2x1[A0, A1, R0]) GetCalls() []Call2x1[A0, A1, R0] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call2x1[A0, A1, R0] { return Call2x1[A0, A1, R0]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub2x1[A0, A1, R0]) GetCall(callIndex int) Call2x1[A0, A1, R0] {
+	return Call2x1[A0, A1, R0]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub2x1[A0, A1, R0]) GetFirstCall() Call2x1[A0, A1, R0] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub2x1[A0, A1, R0]) GetSecondCall() Call2x1[A0, A1, R0] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub2x1[A0, A1, R0]) GetThirdCall() Call2x1[A0, A1, R0] {
+	return s.GetCall(2)
+}
+
+// Call2x1 is the type-safe representation of a call to a function
+// with two arguments and one return value
+type Call2x1[A0, A1, R0 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call2x1[A0, A1, R0]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with
+func (c Call2x1[A0, A1, R0]) Arg1() A1 {
+	return valueAt[A1](c.args, 1)
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call2x1[A0, A1, R0]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// Stub2x2 is a type-safe stub for functions with two arguments and two return values.
+type Stub2x2[A0, A1, R0, R1 any] struct {
+	returns2[func(A0, A1) (R0, R1), R0, R1]
+}
+
+// Function2x2 replaces the provided function, which has
+// two arguments and two return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function2x2[F ~func(A0, A1) (R0, R1), A0, A1, R0, R1 any](testReporter TestReporter, originalFuncPtr *F) *Stub2x2[A0, A1, R0, R1] {
+	return newStub2x2[A0, A1, R0, R1](Function(testReporter, originalFuncPtr))
+}
+
+// newStub2x2 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub2x2[A0, A1, R0, R1 any](stub *Stub) *Stub2x2[A0, A1, R0, R1] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub2x2[A0, A1, R0, R1]{returns2[func(A0, A1) (R0, R1), R0, R1]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub2x2[A0, A1, R0, R1]) WithArgs(a0 A0, a1 A1) *CustomArguments2[func(A0, A1) (R0, R1), R0, R1] {
+	return &CustomArguments2[func(A0, A1) (R0, R1), R0, R1]{s.Stub.WithArgs(a0, a1)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub2x2[A0, A1, R0, R1]) GetCalls() []Call2x2[A0, A1, R0, R1] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call2x2[A0, A1, R0, R1] { return Call2x2[A0, A1, R0, R1]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub2x2[A0, A1, R0, R1]) GetCall(callIndex int) Call2x2[A0, A1, R0, R1] {
+	return Call2x2[A0, A1, R0, R1]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub2x2[A0, A1, R0, R1]) GetFirstCall() Call2x2[A0, A1, R0, R1] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub2x2[A0, A1, R0, R1]) GetSecondCall() Call2x2[A0, A1, R0, R1] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub2x2[A0, A1, R0, R1]) GetThirdCall() Call2x2[A0, A1, R0, R1] {
+	return s.GetCall(2)
+}
+
+// Call2x2 is the type-safe representation of a call to a function
+// with two arguments and two return values
+type Call2x2[A0, A1, R0, R1 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call2x2[A0, A1, R0, R1]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with
+func (c Call2x2[A0, A1, R0, R1]) Arg1() A1 {
+	return valueAt[A1](c.args, 1)
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call2x2[A0, A1, R0, R1]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// ReturnValue1 returns return value 1 of the stubbed implementation
+func (c Call2x2[A0, A1, R0, R1]) ReturnValue1() R1 {
+	return valueAt[R1](c.out, 1)
+}
+
+// Stub2x3 is a type-safe stub for functions with two arguments and three return values.
+type Stub2x3[A0, A1, R0, R1, R2 any] struct {
+	returns3[func(A0, A1) (R0, R1, R2), R0, R1, R2]
+}
+
+// Function2x3 replaces the provided function, which has
+// two arguments and three return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function2x3[F ~func(A0, A1) (R0, R1, R2), A0, A1, R0, R1, R2 any](testReporter TestReporter, originalFuncPtr *F) *Stub2x3[A0, A1, R0, R1, R2] {
+	return newStub2x3[A0, A1, R0, R1, R2](Function(testReporter, originalFuncPtr))
+}
+
+// newStub2x3 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub2x3[A0, A1, R0, R1, R2 any](stub *Stub) *Stub2x3[A0, A1, R0, R1, R2] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub2x3[A0, A1, R0, R1, R2]{returns3[func(A0, A1) (R0, R1, R2), R0, R1, R2]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub2x3[A0, A1, R0, R1, R2]) WithArgs(a0 A0, a1 A1) *CustomArguments3[func(A0, A1) (R0, R1, R2), R0, R1, R2] {
+	return &CustomArguments3[func(A0, A1) (R0, R1, R2), R0, R1, R2]{s.Stub.WithArgs(a0, a1)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub2x3[A0, A1, R0, R1, R2]) GetCalls() []Call2x3[A0, A1, R0, R1, R2] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call2x3[A0, A1, R0, R1, R2] { return Call2x3[A0, A1, R0, R1, R2]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub2x3[A0, A1, R0, R1, R2]) GetCall(callIndex int) Call2x3[A0, A1, R0, R1, R2] {
+	return Call2x3[A0, A1, R0, R1, R2]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub2x3[A0, A1, R0, R1, R2]) GetFirstCall() Call2x3[A0, A1, R0, R1, R2] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub2x3[A0, A1, R0, R1, R2]) GetSecondCall() Call2x3[A0, A1, R0, R1, R2] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub2x3[A0, A1, R0, R1, R2]) GetThirdCall() Call2x3[A0, A1, R0, R1, R2] {
+	return s.GetCall(2)
+}
+
+// Call2x3 is the type-safe representation of a call to a function
+// with two arguments and three return values
+type Call2x3[A0, A1, R0, R1, R2 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call2x3[A0, A1, R0, R1, R2]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with
+func (c Call2x3[A0, A1, R0, R1, R2]) Arg1() A1 {
+	return valueAt[A1](c.args, 1)
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call2x3[A0, A1, R0, R1, R2]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// ReturnValue1 returns return value 1 of the stubbed implementation
+func (c Call2x3[A0, A1, R0, R1, R2]) ReturnValue1() R1 {
+	return valueAt[R1](c.out, 1)
+}
+
+// ReturnValue2 returns return value 2 of the stubbed implementation
+func (c Call2x3[A0, A1, R0, R1, R2]) ReturnValue2() R2 {
+	return valueAt[R2](c.out, 2)
+}
+
+// Stub2Vx0 is a type-safe stub for functions with two arguments, the last of which is variadic, and no return values.
+type Stub2Vx0[A0, A1 any] struct {
+	returns0[func(A0, ...A1)]
+}
+
+// Function2Vx0 replaces the provided function, which has
+// two arguments, the last of which is variadic, and no return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function2Vx0[F ~func(A0, ...A1), A0, A1 any](testReporter TestReporter, originalFuncPtr *F) *Stub2Vx0[A0, A1] {
+	return newStub2Vx0[A0, A1](Function(testReporter, originalFuncPtr))
+}
+
+// newStub2Vx0 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub2Vx0[A0, A1 any](stub *Stub) *Stub2Vx0[A0, A1] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub2Vx0[A0, A1]{returns0[func(A0, ...A1)]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub2Vx0[A0, A1]) WithArgs(a0 A0, a1 ...A1) *CustomArguments0[func(A0, ...A1)] {
+	return &CustomArguments0[func(A0, ...A1)]{s.Stub.WithArgs(appendVariadic([]interface{}{a0}, a1)...)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub2Vx0[A0, A1]) GetCalls() []Call2Vx0[A0, A1] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call2Vx0[A0, A1] { return Call2Vx0[A0, A1]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub2Vx0[A0, A1]) GetCall(callIndex int) Call2Vx0[A0, A1] {
+	return Call2Vx0[A0, A1]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub2Vx0[A0, A1]) GetFirstCall() Call2Vx0[A0, A1] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub2Vx0[A0, A1]) GetSecondCall() Call2Vx0[A0, A1] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub2Vx0[A0, A1]) GetThirdCall() Call2Vx0[A0, A1] {
+	return s.GetCall(2)
+}
+
+// Call2Vx0 is the type-safe representation of a call to a function
+// with two arguments, the last of which is variadic, and no return values
+type Call2Vx0[A0, A1 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call2Vx0[A0, A1]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with, the
+// variadic arguments as a slice
+func (c Call2Vx0[A0, A1]) Arg1() []A1 {
+	return valueAt[[]A1](c.args, 1)
+}
+
+// Stub2Vx1 is a type-safe stub for functions with two arguments, the last of which is variadic, and one return value.
+type Stub2Vx1[A0, A1, R0 any] struct {
+	returns1[func(A0, ...A1) R0, R0]
+}
+
+// Function2Vx1 replaces the provided function, which has
+// two arguments, the last of which is variadic, and one return value, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function2Vx1[F ~func(A0, ...A1) R0, A0, A1, R0 any](testReporter TestReporter, originalFuncPtr *F) *Stub2Vx1[A0, A1, R0] {
+	return newStub2Vx1[A0, A1, R0](Function(testReporter, originalFuncPtr))
+}
+
+// newStub2Vx1 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub2Vx1[A0, A1, R0 any](stub *Stub) *Stub2Vx1[A0, A1, R0] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub2Vx1[A0, A1, R0]{returns1[func(A0, ...A1) R0, R0]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub2Vx1[A0, A1, R0]) WithArgs(a0 A0, a1 ...A1) *CustomArguments1[func(A0, ...A1) R0, R0] {
+	return &CustomArguments1[func(A0, ...A1) R0, R0]{s.Stub.WithArgs(appendVariadic([]interface{}{a0}, a1)...)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub2Vx1[A0, A1, R0]) GetCalls() []Call2Vx1[A0, A1, R0] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call2Vx1[A0, A1, R0] { return Call2Vx1[A0, A1, R0]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub2Vx1[A0, A1, R0]) GetCall(callIndex int) Call2Vx1[A0, A1, R0] {
+	return Call2Vx1[A0, A1, R0]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub2Vx1[A0, A1, R0]) GetFirstCall() Call2Vx1[A0, A1, R0] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub2Vx1[A0, A1, R0]) GetSecondCall() Call2Vx1[A0, A1, R0] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub2Vx1[A0, A1, R0]) GetThirdCall() Call2Vx1[A0, A1, R0] {
+	return s.GetCall(2)
+}
+
+// Call2Vx1 is the type-safe representation of a call to a function
+// with two arguments, the last of which is variadic, and one return value
+type Call2Vx1[A0, A1, R0 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call2Vx1[A0, A1, R0]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with, the
+// variadic arguments as a slice
+func (c Call2Vx1[A0, A1, R0]) Arg1() []A1 {
+	return valueAt[[]A1](c.args, 1)
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call2Vx1[A0, A1, R0]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// Stub2Vx2 is a type-safe stub for functions with two arguments, the last of which is variadic, and two return values.
+type Stub2Vx2[A0, A1, R0, R1 any] struct {
+	returns2[func(A0, ...A1) (R0, R1), R0, R1]
+}
+
+// Function2Vx2 replaces the provided function, which has
+// two arguments, the last of which is variadic, and two return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function2Vx2[F ~func(A0, ...A1) (R0, R1), A0, A1, R0, R1 any](testReporter TestReporter, originalFuncPtr *F) *Stub2Vx2[A0, A1, R0, R1] {
+	return newStub2Vx2[A0, A1, R0, R1](Function(testReporter, originalFuncPtr))
+}
+
+// newStub2Vx2 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub2Vx2[A0, A1, R0, R1 any](stub *Stub) *Stub2Vx2[A0, A1, R0, R1] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub2Vx2[A0, A1, R0, R1]{returns2[func(A0, ...A1) (R0, R1), R0, R1]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub2Vx2[A0, A1, R0, R1]) WithArgs(a0 A0, a1 ...A1) *CustomArguments2[func(A0, ...A1) (R0, R1), R0, R1] {
+	return &CustomArguments2[func(A0, ...A1) (R0, R1), R0, R1]{s.Stub.WithArgs(appendVariadic([]interface{}{a0}, a1)...)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub2Vx2[A0, A1, R0, R1]) GetCalls() []Call2Vx2[A0, A1, R0, R1] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call2Vx2[A0, A1, R0, R1] { return Call2Vx2[A0, A1, R0, R1]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub2Vx2[A0, A1, R0, R1]) GetCall(callIndex int) Call2Vx2[A0, A1, R0, R1] {
+	return Call2Vx2[A0, A1, R0, R1]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub2Vx2[A0, A1, R0, R1]) GetFirstCall() Call2Vx2[A0, A1, R0, R1] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub2Vx2[A0, A1, R0, R1]) GetSecondCall() Call2Vx2[A0, A1, R0, R1] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub2Vx2[A0, A1, R0, R1]) GetThirdCall() Call2Vx2[A0, A1, R0, R1] {
+	return s.GetCall(2)
+}
+
+// Call2Vx2 is the type-safe representation of a call to a function
+// with two arguments, the last of which is variadic, and two return values
+type Call2Vx2[A0, A1, R0, R1 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call2Vx2[A0, A1, R0, R1]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with, the
+// variadic arguments as a slice
+func (c Call2Vx2[A0, A1, R0, R1]) Arg1() []A1 {
+	return valueAt[[]A1](c.args, 1)
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call2Vx2[A0, A1, R0, R1]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// ReturnValue1 returns return value 1 of the stubbed implementation
+func (c Call2Vx2[A0, A1, R0, R1]) ReturnValue1() R1 {
+	return valueAt[R1](c.out, 1)
+}
+
+// Stub2Vx3 is a type-safe stub for functions with two arguments, the last of which is variadic, and three return values.
+type Stub2Vx3[A0, A1, R0, R1, R2 any] struct {
+	returns3[func(A0, ...A1) (R0, R1, R2), R0, R1, R2]
+}
+
+// Function2Vx3 replaces the provided function, which has
+// two arguments, the last of which is variadic, and three return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function2Vx3[F ~func(A0, ...A1) (R0, R1, R2), A0, A1, R0, R1, R2 any](testReporter TestReporter, originalFuncPtr *F) *Stub2Vx3[A0, A1, R0, R1, R2] {
+	return newStub2Vx3[A0, A1, R0, R1, R2](Function(testReporter, originalFuncPtr))
+}
+
+// newStub2Vx3 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub2Vx3[A0, A1, R0, R1, R2 any](stub *Stub) *Stub2Vx3[A0, A1, R0, R1, R2] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub2Vx3[A0, A1, R0, R1, R2]{returns3[func(A0, ...A1) (R0, R1, R2), R0, R1, R2]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub2Vx3[A0, A1, R0, R1, R2]) WithArgs(a0 A0, a1 ...A1) *CustomArguments3[func(A0, ...A1) (R0, R1, R2), R0, R1, R2] {
+	return &CustomArguments3[func(A0, ...A1) (R0, R1, R2), R0, R1, R2]{s.Stub.WithArgs(appendVariadic([]interface{}{a0}, a1)...)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub2Vx3[A0, A1, R0, R1, R2]) GetCalls() []Call2Vx3[A0, A1, R0, R1, R2] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call2Vx3[A0, A1, R0, R1, R2] { return Call2Vx3[A0, A1, R0, R1, R2]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub2Vx3[A0, A1, R0, R1, R2]) GetCall(callIndex int) Call2Vx3[A0, A1, R0, R1, R2] {
+	return Call2Vx3[A0, A1, R0, R1, R2]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub2Vx3[A0, A1, R0, R1, R2]) GetFirstCall() Call2Vx3[A0, A1, R0, R1, R2] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub2Vx3[A0, A1, R0, R1, R2]) GetSecondCall() Call2Vx3[A0, A1, R0, R1, R2] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub2Vx3[A0, A1, R0, R1, R2]) GetThirdCall() Call2Vx3[A0, A1, R0, R1, R2] {
+	return s.GetCall(2)
+}
+
+// Call2Vx3 is the type-safe representation of a call to a function
+// with two arguments, the last of which is variadic, and three return values
+type Call2Vx3[A0, A1, R0, R1, R2 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call2Vx3[A0, A1, R0, R1, R2]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with, the
+// variadic arguments as a slice
+func (c Call2Vx3[A0, A1, R0, R1, R2]) Arg1() []A1 {
+	return valueAt[[]A1](c.args, 1)
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call2Vx3[A0, A1, R0, R1, R2]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// ReturnValue1 returns return value 1 of the stubbed implementation
+func (c Call2Vx3[A0, A1, R0, R1, R2]) ReturnValue1() R1 {
+	return valueAt[R1](c.out, 1)
+}
+
+// ReturnValue2 returns return value 2 of the stubbed implementation
+func (c Call2Vx3[A0, A1, R0, R1, R2]) ReturnValue2() R2 {
+	return valueAt[R2](c.out, 2)
+}
+
+// Stub3x0 is a type-safe stub for functions with three arguments and no return values.
+type Stub3x0[A0, A1, A2 any] struct {
+	returns0[func(A0, A1, A2)]
+}
+
+// Function3x0 replaces the provided function, which has
+// three arguments and no return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function3x0[F ~func(A0, A1, A2), A0, A1, A2 any](testReporter TestReporter, originalFuncPtr *F) *Stub3x0[A0, A1, A2] {
+	return newStub3x0[A0, A1, A2](Function(testReporter, originalFuncPtr))
+}
+
+// newStub3x0 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub3x0[A0, A1, A2 any](stub *Stub) *Stub3x0[A0, A1, A2] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub3x0[A0, A1, A2]{returns0[func(A0, A1, A2)]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub3x0[A0, A1, A2]) WithArgs(a0 A0, a1 A1, a2 A2) *CustomArguments0[func(A0, A1, A2)] {
+	return &CustomArguments0[func(A0, A1, A2)]{s.Stub.WithArgs(a0, a1, a2)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub3x0[A0, A1, A2]) GetCalls() []Call3x0[A0, A1, A2] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call3x0[A0, A1, A2] { return Call3x0[A0, A1, A2]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub3x0[A0, A1, A2]) GetCall(callIndex int) Call3x0[A0, A1, A2] {
+	return Call3x0[A0, A1, A2]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub3x0[A0, A1, A2]) GetFirstCall() Call3x0[A0, A1, A2] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub3x0[A0, A1, A2]) GetSecondCall() Call3x0[A0, A1, A2] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub3x0[A0, A1, A2]) GetThirdCall() Call3x0[A0, A1, A2] {
+	return s.GetCall(2)
+}
+
+// Call3x0 is the type-safe representation of a call to a function
+// with three arguments and no return values
+type Call3x0[A0, A1, A2 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call3x0[A0, A1, A2]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with
+func (c Call3x0[A0, A1, A2]) Arg1() A1 {
+	return valueAt[A1](c.args, 1)
+}
+
+// Arg2 returns argument 2 the stub was called with
+func (c Call3x0[A0, A1, A2]) Arg2() A2 {
+	return valueAt[A2](c.args, 2)
+}
+
+// Stub3x1 is a type-safe stub for functions with three arguments and one return value.
+type Stub3x1[A0, A1, A2, R0 any] struct {
+	returns1[func(A0, A1, A2) R0, R0]
+}
+
+// Function3x1 replaces the provided function, which has
+// three arguments and one return value, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function3x1[F ~func(A0, A1, A2) R0, A0, A1, A2, R0 any](testReporter TestReporter, originalFuncPtr *F) *Stub3x1[A0, A1, A2, R0] {
+	return newStub3x1[A0, A1, A2, R0](Function(testReporter, originalFuncPtr))
+}
+
+// newStub3x1 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub3x1[A0, A1, A2, R0 any](stub *Stub) *Stub3x1[A0, A1, A2, R0] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub3x1[A0, A1, A2, R0]{returns1[func(A0, A1, A2) R0, R0]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub3x1[A0, A1, A2, R0]) WithArgs(a0 A0, a1 A1, a2 A2) *CustomArguments1[func(A0, A1, A2) R0, R0] {
+	return &CustomArguments1[func(A0, A1, A2) R0, R0]{s.Stub.WithArgs(a0, a1, a2)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub3x1[A0, A1, A2, R0]) GetCalls() []Call3x1[A0, A1, A2, R0] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call3x1[A0, A1, A2, R0] { return Call3x1[A0, A1, A2, R0]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub3x1[A0, A1, A2, R0]) GetCall(callIndex int) Call3x1[A0, A1, A2, R0] {
+	return Call3x1[A0, A1, A2, R0]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub3x1[A0, A1, A2, R0]) GetFirstCall() Call3x1[A0, A1, A2, R0] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub3x1[A0, A1, A2, R0]) GetSecondCall() Call3x1[A0, A1, A2, R0] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub3x1[A0, A1, A2, R0]) GetThirdCall() Call3x1[A0, A1, A2, R0] {
+	return s.GetCall(2)
+}
+
+// Call3x1 is the type-safe representation of a call to a function
+// with three arguments and one return value
+type Call3x1[A0, A1, A2, R0 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call3x1[A0, A1, A2, R0]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with
+func (c Call3x1[A0, A1, A2, R0]) Arg1() A1 {
+	return valueAt[A1](c.args, 1)
+}
+
+// Arg2 returns argument 2 the stub was called with
+func (c Call3x1[A0, A1, A2, R0]) Arg2() A2 {
+	return valueAt[A2](c.args, 2)
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call3x1[A0, A1, A2, R0]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// Stub3x2 is a type-safe stub for functions with three arguments and two return values.
+type Stub3x2[A0, A1, A2, R0, R1 any] struct {
+	returns2[func(A0, A1, A2) (R0, R1), R0, R1]
+}
+
+// Function3x2 replaces the provided function, which has
+// three arguments and two return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function3x2[F ~func(A0, A1, A2) (R0, R1), A0, A1, A2, R0, R1 any](testReporter TestReporter, originalFuncPtr *F) *Stub3x2[A0, A1, A2, R0, R1] {
+	return newStub3x2[A0, A1, A2, R0, R1](Function(testReporter, originalFuncPtr))
+}
+
+// newStub3x2 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub3x2[A0, A1, A2, R0, R1 any](stub *Stub) *Stub3x2[A0, A1, A2, R0, R1] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub3x2[A0, A1, A2, R0, R1]{returns2[func(A0, A1, A2) (R0, R1), R0, R1]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub3x2[A0, A1, A2, R0, R1]) WithArgs(a0 A0, a1 A1, a2 A2) *CustomArguments2[func(A0, A1, A2) (R0, R1), R0, R1] {
+	return &CustomArguments2[func(A0, A1, A2) (R0, R1), R0, R1]{s.Stub.WithArgs(a0, a1, a2)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub3x2[A0, A1, A2, R0, R1]) GetCalls() []Call3x2[A0, A1, A2, R0, R1] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call3x2[A0, A1, A2, R0, R1] { return Call3x2[A0, A1, A2, R0, R1]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub3x2[A0, A1, A2, R0, R1]) GetCall(callIndex int) Call3x2[A0, A1, A2, R0, R1] {
+	return Call3x2[A0, A1, A2, R0, R1]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub3x2[A0, A1, A2, R0, R1]) GetFirstCall() Call3x2[A0, A1, A2, R0, R1] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub3x2[A0, A1, A2, R0, R1]) GetSecondCall() Call3x2[A0, A1, A2, R0, R1] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub3x2[A0, A1, A2, R0, R1]) GetThirdCall() Call3x2[A0, A1, A2, R0, R1] {
+	return s.GetCall(2)
+}
+
+// Call3x2 is the type-safe representation of a call to a function
+// with three arguments and two return values
+type Call3x2[A0, A1, A2, R0, R1 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call3x2[A0, A1, A2, R0, R1]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with
+func (c Call3x2[A0, A1, A2, R0, R1]) Arg1() A1 {
+	return valueAt[A1](c.args, 1)
+}
+
+// Arg2 returns argument 2 the stub was called with
+func (c Call3x2[A0, A1, A2, R0, R1]) Arg2() A2 {
+	return valueAt[A2](c.args, 2)
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call3x2[A0, A1, A2, R0, R1]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// ReturnValue1 returns return value 1 of the stubbed implementation
+func (c Call3x2[A0, A1, A2, R0, R1]) ReturnValue1() R1 {
+	return valueAt[R1](c.out, 1)
+}
+
+// Stub3x3 is a type-safe stub for functions with three arguments and three return values.
+type Stub3x3[A0, A1, A2, R0, R1, R2 any] struct {
+	returns3[func(A0, A1, A2) (R0, R1, R2), R0, R1, R2]
+}
+
+// Function3x3 replaces the provided function, which has
+// three arguments and three return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function3x3[F ~func(A0, A1, A2) (R0, R1, R2), A0, A1, A2, R0, R1, R2 any](testReporter TestReporter, originalFuncPtr *F) *Stub3x3[A0, A1, A2, R0, R1, R2] {
+	return newStub3x3[A0, A1, A2, R0, R1, R2](Function(testReporter, originalFuncPtr))
+}
+
+// newStub3x3 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub3x3[A0, A1, A2, R0, R1, R2 any](stub *Stub) *Stub3x3[A0, A1, A2, R0, R1, R2] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub3x3[A0, A1, A2, R0, R1, R2]{returns3[func(A0, A1, A2) (R0, R1, R2), R0, R1, R2]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub3x3[A0, A1, A2, R0, R1, R2]) WithArgs(a0 A0, a1 A1, a2 A2) *CustomArguments3[func(A0, A1, A2) (R0, R1, R2), R0, R1, R2] {
+	return &CustomArguments3[func(A0, A1, A2) (R0, R1, R2), R0, R1, R2]{s.Stub.WithArgs(a0, a1, a2)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub3x3[A0, A1, A2, R0, R1, R2]) GetCalls() []Call3x3[A0, A1, A2, R0, R1, R2] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call3x3[A0, A1, A2, R0, R1, R2] { return Call3x3[A0, A1, A2, R0, R1, R2]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub3x3[A0, A1, A2, R0, R1, R2]) GetCall(callIndex int) Call3x3[A0, A1, A2, R0, R1, R2] {
+	return Call3x3[A0, A1, A2, R0, R1, R2]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub3x3[A0, A1, A2, R0, R1, R2]) GetFirstCall() Call3x3[A0, A1, A2, R0, R1, R2] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub3x3[A0, A1, A2, R0, R1, R2]) GetSecondCall() Call3x3[A0, A1, A2, R0, R1, R2] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub3x3[A0, A1, A2, R0, R1, R2]) GetThirdCall() Call3x3[A0, A1, A2, R0, R1, R2] {
+	return s.GetCall(2)
+}
+
+// Call3x3 is the type-safe representation of a call to a function
+// with three arguments and three return values
+type Call3x3[A0, A1, A2, R0, R1, R2 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call3x3[A0, A1, A2, R0, R1, R2]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with
+func (c Call3x3[A0, A1, A2, R0, R1, R2]) Arg1() A1 {
+	return valueAt[A1](c.args, 1)
+}
+
+// Arg2 returns argument 2 the stub was called with
+func (c Call3x3[A0, A1, A2, R0, R1, R2]) Arg2() A2 {
+	return valueAt[A2](c.args, 2)
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call3x3[A0, A1, A2, R0, R1, R2]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// ReturnValue1 returns return value 1 of the stubbed implementation
+func (c Call3x3[A0, A1, A2, R0, R1, R2]) ReturnValue1() R1 {
+	return valueAt[R1](c.out, 1)
+}
+
+// ReturnValue2 returns return value 2 of the stubbed implementation
+func (c Call3x3[A0, A1, A2, R0, R1, R2]) ReturnValue2() R2 {
+	return valueAt[R2](c.out, 2)
+}
+
+// Stub3Vx0 is a type-safe stub for functions with three arguments, the last of which is variadic, and no return values.
+type Stub3Vx0[A0, A1, A2 any] struct {
+	returns0[func(A0, A1, ...A2)]
+}
+
+// Function3Vx0 replaces the provided function, which has
+// three arguments, the last of which is variadic, and no return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function3Vx0[F ~func(A0, A1, ...A2), A0, A1, A2 any](testReporter TestReporter, originalFuncPtr *F) *Stub3Vx0[A0, A1, A2] {
+	return newStub3Vx0[A0, A1, A2](Function(testReporter, originalFuncPtr))
+}
+
+// newStub3Vx0 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub3Vx0[A0, A1, A2 any](stub *Stub) *Stub3Vx0[A0, A1, A2] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub3Vx0[A0, A1, A2]{returns0[func(A0, A1, ...A2)]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub3Vx0[A0, A1, A2]) WithArgs(a0 A0, a1 A1, a2 ...A2) *CustomArguments0[func(A0, A1, ...A2)] {
+	return &CustomArguments0[func(A0, A1, ...A2)]{s.Stub.WithArgs(appendVariadic([]interface{}{a0, a1}, a2)...)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub3Vx0[A0, A1, A2]) GetCalls() []Call3Vx0[A0, A1, A2] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call3Vx0[A0, A1, A2] { return Call3Vx0[A0, A1, A2]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub3Vx0[A0, A1, A2]) GetCall(callIndex int) Call3Vx0[A0, A1, A2] {
+	return Call3Vx0[A0, A1, A2]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub3Vx0[A0, A1, A2]) GetFirstCall() Call3Vx0[A0, A1, A2] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub3Vx0[A0, A1, A2]) GetSecondCall() Call3Vx0[A0, A1, A2] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub3Vx0[A0, A1, A2]) GetThirdCall() Call3Vx0[A0, A1, A2] {
+	return s.GetCall(2)
+}
+
+// Call3Vx0 is the type-safe representation of a call to a function
+// with three arguments, the last of which is variadic, and no return values
+type Call3Vx0[A0, A1, A2 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call3Vx0[A0, A1, A2]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with
+func (c Call3Vx0[A0, A1, A2]) Arg1() A1 {
+	return valueAt[A1](c.args, 1)
+}
+
+// Arg2 returns argument 2 the stub was called with, the
+// variadic arguments as a slice
+func (c Call3Vx0[A0, A1, A2]) Arg2() []A2 {
+	return valueAt[[]A2](c.args, 2)
+}
+
+// Stub3Vx1 is a type-safe stub for functions with three arguments, the last of which is variadic, and one return value.
+type Stub3Vx1[A0, A1, A2, R0 any] struct {
+	returns1[func(A0, A1, ...A2) R0, R0]
+}
+
+// Function3Vx1 replaces the provided function, which has
+// three arguments, the last of which is variadic, and one return value, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function3Vx1[F ~func(A0, A1, ...A2) R0, A0, A1, A2, R0 any](testReporter TestReporter, originalFuncPtr *F) *Stub3Vx1[A0, A1, A2, R0] {
+	return newStub3Vx1[A0, A1, A2, R0](Function(testReporter, originalFuncPtr))
+}
+
+// newStub3Vx1 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub3Vx1[A0, A1, A2, R0 any](stub *Stub) *Stub3Vx1[A0, A1, A2, R0] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub3Vx1[A0, A1, A2, R0]{returns1[func(A0, A1, ...A2) R0, R0]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub3Vx1[A0, A1, A2, R0]) WithArgs(a0 A0, a1 A1, a2 ...A2) *CustomArguments1[func(A0, A1, ...A2) R0, R0] {
+	return &CustomArguments1[func(A0, A1, ...A2) R0, R0]{s.Stub.WithArgs(appendVariadic([]interface{}{a0, a1}, a2)...)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub3Vx1[A0, A1, A2, R0]) GetCalls() []Call3Vx1[A0, A1, A2, R0] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call3Vx1[A0, A1, A2, R0] { return Call3Vx1[A0, A1, A2, R0]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub3Vx1[A0, A1, A2, R0]) GetCall(callIndex int) Call3Vx1[A0, A1, A2, R0] {
+	return Call3Vx1[A0, A1, A2, R0]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub3Vx1[A0, A1, A2, R0]) GetFirstCall() Call3Vx1[A0, A1, A2, R0] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub3Vx1[A0, A1, A2, R0]) GetSecondCall() Call3Vx1[A0, A1, A2, R0] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub3Vx1[A0, A1, A2, R0]) GetThirdCall() Call3Vx1[A0, A1, A2, R0] {
+	return s.GetCall(2)
+}
+
+// Call3Vx1 is the type-safe representation of a call to a function
+// with three arguments, the last of which is variadic, and one return value
+type Call3Vx1[A0, A1, A2, R0 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call3Vx1[A0, A1, A2, R0]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with
+func (c Call3Vx1[A0, A1, A2, R0]) Arg1() A1 {
+	return valueAt[A1](c.args, 1)
+}
+
+// Arg2 returns argument 2 the stub was called with, the
+// variadic arguments as a slice
+func (c Call3Vx1[A0, A1, A2, R0]) Arg2() []A2 {
+	return valueAt[[]A2](c.args, 2)
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call3Vx1[A0, A1, A2, R0]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// Stub3Vx2 is a type-safe stub for functions with three arguments, the last of which is variadic, and two return values.
+type Stub3Vx2[A0, A1, A2, R0, R1 any] struct {
+	returns2[func(A0, A1, ...A2) (R0, R1), R0, R1]
+}
+
+// Function3Vx2 replaces the provided function, which has
+// three arguments, the last of which is variadic, and two return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function3Vx2[F ~func(A0, A1, ...A2) (R0, R1), A0, A1, A2, R0, R1 any](testReporter TestReporter, originalFuncPtr *F) *Stub3Vx2[A0, A1, A2, R0, R1] {
+	return newStub3Vx2[A0, A1, A2, R0, R1](Function(testReporter, originalFuncPtr))
+}
+
+// newStub3Vx2 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub3Vx2[A0, A1, A2, R0, R1 any](stub *Stub) *Stub3Vx2[A0, A1, A2, R0, R1] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub3Vx2[A0, A1, A2, R0, R1]{returns2[func(A0, A1, ...A2) (R0, R1), R0, R1]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub3Vx2[A0, A1, A2, R0, R1]) WithArgs(a0 A0, a1 A1, a2 ...A2) *CustomArguments2[func(A0, A1, ...A2) (R0, R1), R0, R1] {
+	return &CustomArguments2[func(A0, A1, ...A2) (R0, R1), R0, R1]{s.Stub.WithArgs(appendVariadic([]interface{}{a0, a1}, a2)...)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub3Vx2[A0, A1, A2, R0, R1]) GetCalls() []Call3Vx2[A0, A1, A2, R0, R1] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call3Vx2[A0, A1, A2, R0, R1] { return Call3Vx2[A0, A1, A2, R0, R1]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub3Vx2[A0, A1, A2, R0, R1]) GetCall(callIndex int) Call3Vx2[A0, A1, A2, R0, R1] {
+	return Call3Vx2[A0, A1, A2, R0, R1]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub3Vx2[A0, A1, A2, R0, R1]) GetFirstCall() Call3Vx2[A0, A1, A2, R0, R1] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub3Vx2[A0, A1, A2, R0, R1]) GetSecondCall() Call3Vx2[A0, A1, A2, R0, R1] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub3Vx2[A0, A1, A2, R0, R1]) GetThirdCall() Call3Vx2[A0, A1, A2, R0, R1] {
+	return s.GetCall(2)
+}
+
+// Call3Vx2 is the type-safe representation of a call to a function
+// with three arguments, the last of which is variadic, and two return values
+type Call3Vx2[A0, A1, A2, R0, R1 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call3Vx2[A0, A1, A2, R0, R1]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with
+func (c Call3Vx2[A0, A1, A2, R0, R1]) Arg1() A1 {
+	return valueAt[A1](c.args, 1)
+}
+
+// Arg2 returns argument 2 the stub was called with, the
+// variadic arguments as a slice
+func (c Call3Vx2[A0, A1, A2, R0, R1]) Arg2() []A2 {
+	return valueAt[[]A2](c.args, 2)
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call3Vx2[A0, A1, A2, R0, R1]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// ReturnValue1 returns return value 1 of the stubbed implementation
+func (c Call3Vx2[A0, A1, A2, R0, R1]) ReturnValue1() R1 {
+	return valueAt[R1](c.out, 1)
+}
+
+// Stub3Vx3 is a type-safe stub for functions with three arguments, the last of which is variadic, and three return values.
+type Stub3Vx3[A0, A1, A2, R0, R1, R2 any] struct {
+	returns3[func(A0, A1, ...A2) (R0, R1, R2), R0, R1, R2]
+}
+
+// Function3Vx3 replaces the provided function, which has
+// three arguments, the last of which is variadic, and three return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function3Vx3[F ~func(A0, A1, ...A2) (R0, R1, R2), A0, A1, A2, R0, R1, R2 any](testReporter TestReporter, originalFuncPtr *F) *Stub3Vx3[A0, A1, A2, R0, R1, R2] {
+	return newStub3Vx3[A0, A1, A2, R0, R1, R2](Function(testReporter, originalFuncPtr))
+}
+
+// newStub3Vx3 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub3Vx3[A0, A1, A2, R0, R1, R2 any](stub *Stub) *Stub3Vx3[A0, A1, A2, R0, R1, R2] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub3Vx3[A0, A1, A2, R0, R1, R2]{returns3[func(A0, A1, ...A2) (R0, R1, R2), R0, R1, R2]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub3Vx3[A0, A1, A2, R0, R1, R2]) WithArgs(a0 A0, a1 A1, a2 ...A2) *CustomArguments3[func(A0, A1, ...A2) (R0, R1, R2), R0, R1, R2] {
+	return &CustomArguments3[func(A0, A1, ...A2) (R0, R1, R2), R0, R1, R2]{s.Stub.WithArgs(appendVariadic([]interface{}{a0, a1}, a2)...)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub3Vx3[A0, A1, A2, R0, R1, R2]) GetCalls() []Call3Vx3[A0, A1, A2, R0, R1, R2] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call3Vx3[A0, A1, A2, R0, R1, R2] { return Call3Vx3[A0, A1, A2, R0, R1, R2]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub3Vx3[A0, A1, A2, R0, R1, R2]) GetCall(callIndex int) Call3Vx3[A0, A1, A2, R0, R1, R2] {
+	return Call3Vx3[A0, A1, A2, R0, R1, R2]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub3Vx3[A0, A1, A2, R0, R1, R2]) GetFirstCall() Call3Vx3[A0, A1, A2, R0, R1, R2] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub3Vx3[A0, A1, A2, R0, R1, R2]) GetSecondCall() Call3Vx3[A0, A1, A2, R0, R1, R2] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub3Vx3[A0, A1, A2, R0, R1, R2]) GetThirdCall() Call3Vx3[A0, A1, A2, R0, R1, R2] {
+	return s.GetCall(2)
+}
+
+// Call3Vx3 is the type-safe representation of a call to a function
+// with three arguments, the last of which is variadic, and three return values
+type Call3Vx3[A0, A1, A2, R0, R1, R2 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call3Vx3[A0, A1, A2, R0, R1, R2]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with
+func (c Call3Vx3[A0, A1, A2, R0, R1, R2]) Arg1() A1 {
+	return valueAt[A1](c.args, 1)
+}
+
+// Arg2 returns argument 2 the stub was called with, the
+// variadic arguments as a slice
+func (c Call3Vx3[A0, A1, A2, R0, R1, R2]) Arg2() []A2 {
+	return valueAt[[]A2](c.args, 2)
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call3Vx3[A0, A1, A2, R0, R1, R2]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// ReturnValue1 returns return value 1 of the stubbed implementation
+func (c Call3Vx3[A0, A1, A2, R0, R1, R2]) ReturnValue1() R1 {
+	return valueAt[R1](c.out, 1)
+}
+
+// ReturnValue2 returns return value 2 of the stubbed implementation
+func (c Call3Vx3[A0, A1, A2, R0, R1, R2]) ReturnValue2() R2 {
+	return valueAt[R2](c.out, 2)
+}
+
+// Stub4x0 is a type-safe stub for functions with four arguments and no return values.
+type Stub4x0[A0, A1, A2, A3 any] struct {
+	returns0[func(A0, A1, A2, A3)]
+}
+
+// Function4x0 replaces the provided function, which has
+// four arguments and no return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function4x0[F ~func(A0, A1, A2, A3), A0, A1, A2, A3 any](testReporter TestReporter, originalFuncPtr *F) *Stub4x0[A0, A1, A2, A3] {
+	return newStub4x0[A0, A1, A2, A3](Function(testReporter, originalFuncPtr))
+}
+
+// newStub4x0 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub4x0[A0, A1, A2, A3 any](stub *Stub) *Stub4x0[A0, A1, A2, A3] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub4x0[A0, A1, A2, A3]{returns0[func(A0, A1, A2, A3)]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub4x0[A0, A1, A2, A3]) WithArgs(a0 A0, a1 A1, a2 A2, a3 A3) *CustomArguments0[func(A0, A1, A2, A3)] {
+	return &CustomArguments0[func(A0, A1, A2, A3)]{s.Stub.WithArgs(a0, a1, a2, a3)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub4x0[A0, A1, A2, A3]) GetCalls() []Call4x0[A0, A1, A2, A3] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call4x0[A0, A1, A2, A3] { return Call4x0[A0, A1, A2, A3]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub4x0[A0, A1, A2, A3]) GetCall(callIndex int) Call4x0[A0, A1, A2, A3] {
+	return Call4x0[A0, A1, A2, A3]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub4x0[A0, A1, A2, A3]) GetFirstCall() Call4x0[A0, A1, A2, A3] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub4x0[A0, A1, A2, A3]) GetSecondCall() Call4x0[A0, A1, A2, A3] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub4x0[A0, A1, A2, A3]) GetThirdCall() Call4x0[A0, A1, A2, A3] {
+	return s.GetCall(2)
+}
+
+// Call4x0 is the type-safe representation of a call to a function
+// with four arguments and no return values
+type Call4x0[A0, A1, A2, A3 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call4x0[A0, A1, A2, A3]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with
+func (c Call4x0[A0, A1, A2, A3]) Arg1() A1 {
+	return valueAt[A1](c.args, 1)
+}
+
+// Arg2 returns argument 2 the stub was called with
+func (c Call4x0[A0, A1, A2, A3]) Arg2() A2 {
+	return valueAt[A2](c.args, 2)
+}
+
+// Arg3 returns argument 3 the stub was called with
+func (c Call4x0[A0, A1, A2, A3]) Arg3() A3 {
+	return valueAt[A3](c.args, 3)
+}
+
+// Stub4x1 is a type-safe stub for functions with four arguments and one return value.
+type Stub4x1[A0, A1, A2, A3, R0 any] struct {
+	returns1[func(A0, A1, A2, A3) R0, R0]
+}
+
+// Function4x1 replaces the provided function, which has
+// four arguments and one return value, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function4x1[F ~func(A0, A1, A2, A3) R0, A0, A1, A2, A3, R0 any](testReporter TestReporter, originalFuncPtr *F) *Stub4x1[A0, A1, A2, A3, R0] {
+	return newStub4x1[A0, A1, A2, A3, R0](Function(testReporter, originalFuncPtr))
+}
+
+// newStub4x1 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub4x1[A0, A1, A2, A3, R0 any](stub *Stub) *Stub4x1[A0, A1, A2, A3, R0] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub4x1[A0, A1, A2, A3, R0]{returns1[func(A0, A1, A2, A3) R0, R0]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub4x1[A0, A1, A2, A3, R0]) WithArgs(a0 A0, a1 A1, a2 A2, a3 A3) *CustomArguments1[func(A0, A1, A2, A3) R0, R0] {
+	return &CustomArguments1[func(A0, A1, A2, A3) R0, R0]{s.Stub.WithArgs(a0, a1, a2, a3)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub4x1[A0, A1, A2, A3, R0]) GetCalls() []Call4x1[A0, A1, A2, A3, R0] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call4x1[A0, A1, A2, A3, R0] { return Call4x1[A0, A1, A2, A3, R0]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub4x1[A0, A1, A2, A3, R0]) GetCall(callIndex int) Call4x1[A0, A1, A2, A3, R0] {
+	return Call4x1[A0, A1, A2, A3, R0]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub4x1[A0, A1, A2, A3, R0]) GetFirstCall() Call4x1[A0, A1, A2, A3, R0] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub4x1[A0, A1, A2, A3, R0]) GetSecondCall() Call4x1[A0, A1, A2, A3, R0] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub4x1[A0, A1, A2, A3, R0]) GetThirdCall() Call4x1[A0, A1, A2, A3, R0] {
+	return s.GetCall(2)
+}
+
+// Call4x1 is the type-safe representation of a call to a function
+// with four arguments and one return value
+type Call4x1[A0, A1, A2, A3, R0 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call4x1[A0, A1, A2, A3, R0]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with
+func (c Call4x1[A0, A1, A2, A3, R0]) Arg1() A1 {
+	return valueAt[A1](c.args, 1)
+}
+
+// Arg2 returns argument 2 the stub was called with
+func (c Call4x1[A0, A1, A2, A3, R0]) Arg2() A2 {
+	return valueAt[A2](c.args, 2)
+}
+
+// Arg3 returns argument 3 the stub was called with
+func (c Call4x1[A0, A1, A2, A3, R0]) Arg3() A3 {
+	return valueAt[A3](c.args, 3)
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call4x1[A0, A1, A2, A3, R0]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// Stub4x2 is a type-safe stub for functions with four arguments and two return values.
+type Stub4x2[A0, A1, A2, A3, R0, R1 any] struct {
+	returns2[func(A0, A1, A2, A3) (R0, R1), R0, R1]
+}
+
+// Function4x2 replaces the provided function, which has
+// four arguments and two return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function4x2[F ~func(A0, A1, A2, A3) (R0, R1), A0, A1, A2, A3, R0, R1 any](testReporter TestReporter, originalFuncPtr *F) *Stub4x2[A0, A1, A2, A3, R0, R1] {
+	return newStub4x2[A0, A1, A2, A3, R0, R1](Function(testReporter, originalFuncPtr))
+}
+
+// newStub4x2 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub4x2[A0, A1, A2, A3, R0, R1 any](stub *Stub) *Stub4x2[A0, A1, A2, A3, R0, R1] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub4x2[A0, A1, A2, A3, R0, R1]{returns2[func(A0, A1, A2, A3) (R0, R1), R0, R1]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub4x2[A0, A1, A2, A3, R0, R1]) WithArgs(a0 A0, a1 A1, a2 A2, a3 A3) *CustomArguments2[func(A0, A1, A2, A3) (R0, R1), R0, R1] {
+	return &CustomArguments2[func(A0, A1, A2, A3) (R0, R1), R0, R1]{s.Stub.WithArgs(a0, a1, a2, a3)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub4x2[A0, A1, A2, A3, R0, R1]) GetCalls() []Call4x2[A0, A1, A2, A3, R0, R1] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call4x2[A0, A1, A2, A3, R0, R1] { return Call4x2[A0, A1, A2, A3, R0, R1]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub4x2[A0, A1, A2, A3, R0, R1]) GetCall(callIndex int) Call4x2[A0, A1, A2, A3, R0, R1] {
+	return Call4x2[A0, A1, A2, A3, R0, R1]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub4x2[A0, A1, A2, A3, R0, R1]) GetFirstCall() Call4x2[A0, A1, A2, A3, R0, R1] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub4x2[A0, A1, A2, A3, R0, R1]) GetSecondCall() Call4x2[A0, A1, A2, A3, R0, R1] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub4x2[A0, A1, A2, A3, R0, R1]) GetThirdCall() Call4x2[A0, A1, A2, A3, R0, R1] {
+	return s.GetCall(2)
+}
+
+// Call4x2 is the type-safe representation of a call to a function
+// with four arguments and two return values
+type Call4x2[A0, A1, A2, A3, R0, R1 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call4x2[A0, A1, A2, A3, R0, R1]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with
+func (c Call4x2[A0, A1, A2, A3, R0, R1]) Arg1() A1 {
+	return valueAt[A1](c.args, 1)
+}
+
+// Arg2 returns argument 2 the stub was called with
+func (c Call4x2[A0, A1, A2, A3, R0, R1]) Arg2() A2 {
+	return valueAt[A2](c.args, 2)
+}
+
+// Arg3 returns argument 3 the stub was called with
+func (c Call4x2[A0, A1, A2, A3, R0, R1]) Arg3() A3 {
+	return valueAt[A3](c.args, 3)
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call4x2[A0, A1, A2, A3, R0, R1]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// ReturnValue1 returns return value 1 of the stubbed implementation
+func (c Call4x2[A0, A1, A2, A3, R0, R1]) ReturnValue1() R1 {
+	return valueAt[R1](c.out, 1)
+}
+
+// Stub4x3 is a type-safe stub for functions with four arguments and three return values.
+type Stub4x3[A0, A1, A2, A3, R0, R1, R2 any] struct {
+	returns3[func(A0, A1, A2, A3) (R0, R1, R2), R0, R1, R2]
+}
+
+// Function4x3 replaces the provided function, which has
+// four arguments and three return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function4x3[F ~func(A0, A1, A2, A3) (R0, R1, R2), A0, A1, A2, A3, R0, R1, R2 any](testReporter TestReporter, originalFuncPtr *F) *Stub4x3[A0, A1, A2, A3, R0, R1, R2] {
+	return newStub4x3[A0, A1, A2, A3, R0, R1, R2](Function(testReporter, originalFuncPtr))
+}
+
+// newStub4x3 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub4x3[A0, A1, A2, A3, R0, R1, R2 any](stub *Stub) *Stub4x3[A0, A1, A2, A3, R0, R1, R2] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub4x3[A0, A1, A2, A3, R0, R1, R2]{returns3[func(A0, A1, A2, A3) (R0, R1, R2), R0, R1, R2]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub4x3[A0, A1, A2, A3, R0, R1, R2]) WithArgs(a0 A0, a1 A1, a2 A2, a3 A3) *CustomArguments3[func(A0, A1, A2, A3) (R0, R1, R2), R0, R1, R2] {
+	return &CustomArguments3[func(A0, A1, A2, A3) (R0, R1, R2), R0, R1, R2]{s.Stub.WithArgs(a0, a1, a2, a3)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub4x3[A0, A1, A2, A3, R0, R1, R2]) GetCalls() []Call4x3[A0, A1, A2, A3, R0, R1, R2] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call4x3[A0, A1, A2, A3, R0, R1, R2] { return Call4x3[A0, A1, A2, A3, R0, R1, R2]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub4x3[A0, A1, A2, A3, R0, R1, R2]) GetCall(callIndex int) Call4x3[A0, A1, A2, A3, R0, R1, R2] {
+	return Call4x3[A0, A1, A2, A3, R0, R1, R2]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub4x3[A0, A1, A2, A3, R0, R1, R2]) GetFirstCall() Call4x3[A0, A1, A2, A3, R0, R1, R2] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub4x3[A0, A1, A2, A3, R0, R1, R2]) GetSecondCall() Call4x3[A0, A1, A2, A3, R0, R1, R2] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub4x3[A0, A1, A2, A3, R0, R1, R2]) GetThirdCall() Call4x3[A0, A1, A2, A3, R0, R1, R2] {
+	return s.GetCall(2)
+}
+
+// Call4x3 is the type-safe representation of a call to a function
+// with four arguments and three return values
+type Call4x3[A0, A1, A2, A3, R0, R1, R2 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call4x3[A0, A1, A2, A3, R0, R1, R2]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with
+func (c Call4x3[A0, A1, A2, A3, R0, R1, R2]) Arg1() A1 {
+	return valueAt[A1](c.args, 1)
+}
+
+// Arg2 returns argument 2 the stub was called with
+func (c Call4x3[A0, A1, A2, A3, R0, R1, R2]) Arg2() A2 {
+	return valueAt[A2](c.args, 2)
+}
+
+// Arg3 returns argument 3 the stub was called with
+func (c Call4x3[A0, A1, A2, A3, R0, R1, R2]) Arg3() A3 {
+	return valueAt[A3](c.args, 3)
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call4x3[A0, A1, A2, A3, R0, R1, R2]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// ReturnValue1 returns return value 1 of the stubbed implementation
+func (c Call4x3[A0, A1, A2, A3, R0, R1, R2]) ReturnValue1() R1 {
+	return valueAt[R1](c.out, 1)
+}
+
+// ReturnValue2 returns return value 2 of the stubbed implementation
+func (c Call4x3[A0, A1, A2, A3, R0, R1, R2]) ReturnValue2() R2 {
+	return valueAt[R2](c.out, 2)
+}
+
+// Stub4Vx0 is a type-safe stub for functions with four arguments, the last of which is variadic, and no return values.
+type Stub4Vx0[A0, A1, A2, A3 any] struct {
+	returns0[func(A0, A1, A2, ...A3)]
+}
+
+// Function4Vx0 replaces the provided function, which has
+// four arguments, the last of which is variadic, and no return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function4Vx0[F ~func(A0, A1, A2, ...A3), A0, A1, A2, A3 any](testReporter TestReporter, originalFuncPtr *F) *Stub4Vx0[A0, A1, A2, A3] {
+	return newStub4Vx0[A0, A1, A2, A3](Function(testReporter, originalFuncPtr))
+}
+
+// newStub4Vx0 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub4Vx0[A0, A1, A2, A3 any](stub *Stub) *Stub4Vx0[A0, A1, A2, A3] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub4Vx0[A0, A1, A2, A3]{returns0[func(A0, A1, A2, ...A3)]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub4Vx0[A0, A1, A2, A3]) WithArgs(a0 A0, a1 A1, a2 A2, a3 ...A3) *CustomArguments0[func(A0, A1, A2, ...A3)] {
+	return &CustomArguments0[func(A0, A1, A2, ...A3)]{s.Stub.WithArgs(appendVariadic([]interface{}{a0, a1, a2}, a3)...)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub4Vx0[A0, A1, A2, A3]) GetCalls() []Call4Vx0[A0, A1, A2, A3] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call4Vx0[A0, A1, A2, A3] { return Call4Vx0[A0, A1, A2, A3]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub4Vx0[A0, A1, A2, A3]) GetCall(callIndex int) Call4Vx0[A0, A1, A2, A3] {
+	return Call4Vx0[A0, A1, A2, A3]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub4Vx0[A0, A1, A2, A3]) GetFirstCall() Call4Vx0[A0, A1, A2, A3] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub4Vx0[A0, A1, A2, A3]) GetSecondCall() Call4Vx0[A0, A1, A2, A3] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub4Vx0[A0, A1, A2, A3]) GetThirdCall() Call4Vx0[A0, A1, A2, A3] {
+	return s.GetCall(2)
+}
+
+// Call4Vx0 is the type-safe representation of a call to a function
+// with four arguments, the last of which is variadic, and no return values
+type Call4Vx0[A0, A1, A2, A3 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call4Vx0[A0, A1, A2, A3]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with
+func (c Call4Vx0[A0, A1, A2, A3]) Arg1() A1 {
+	return valueAt[A1](c.args, 1)
+}
+
+// Arg2 returns argument 2 the stub was called with
+func (c Call4Vx0[A0, A1, A2, A3]) Arg2() A2 {
+	return valueAt[A2](c.args, 2)
+}
+
+// Arg3 returns argument 3 the stub was called with, the
+// variadic arguments as a slice
+func (c Call4Vx0[A0, A1, A2, A3]) Arg3() []A3 {
+	return valueAt[[]A3](c.args, 3)
+}
+
+// Stub4Vx1 is a type-safe stub for functions with four arguments, the last of which is variadic, and one return value.
+type Stub4Vx1[A0, A1, A2, A3, R0 any] struct {
+	returns1[func(A0, A1, A2, ...A3) R0, R0]
+}
+
+// Function4Vx1 replaces the provided function, which has
+// four arguments, the last of which is variadic, and one return value, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function4Vx1[F ~func(A0, A1, A2, ...A3) R0, A0, A1, A2, A3, R0 any](testReporter TestReporter, originalFuncPtr *F) *Stub4Vx1[A0, A1, A2, A3, R0] {
+	return newStub4Vx1[A0, A1, A2, A3, R0](Function(testReporter, originalFuncPtr))
+}
+
+// newStub4Vx1 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub4Vx1[A0, A1, A2, A3, R0 any](stub *Stub) *Stub4Vx1[A0, A1, A2, A3, R0] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub4Vx1[A0, A1, A2, A3, R0]{returns1[func(A0, A1, A2, ...A3) R0, R0]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub4Vx1[A0, A1, A2, A3, R0]) WithArgs(a0 A0, a1 A1, a2 A2, a3 ...A3) *CustomArguments1[func(A0, A1, A2, ...A3) R0, R0] {
+	return &CustomArguments1[func(A0, A1, A2, ...A3) R0, R0]{s.Stub.WithArgs(appendVariadic([]interface{}{a0, a1, a2}, a3)...)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub4Vx1[A0, A1, A2, A3, R0]) GetCalls() []Call4Vx1[A0, A1, A2, A3, R0] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call4Vx1[A0, A1, A2, A3, R0] { return Call4Vx1[A0, A1, A2, A3, R0]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub4Vx1[A0, A1, A2, A3, R0]) GetCall(callIndex int) Call4Vx1[A0, A1, A2, A3, R0] {
+	return Call4Vx1[A0, A1, A2, A3, R0]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub4Vx1[A0, A1, A2, A3, R0]) GetFirstCall() Call4Vx1[A0, A1, A2, A3, R0] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub4Vx1[A0, A1, A2, A3, R0]) GetSecondCall() Call4Vx1[A0, A1, A2, A3, R0] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub4Vx1[A0, A1, A2, A3, R0]) GetThirdCall() Call4Vx1[A0, A1, A2, A3, R0] {
+	return s.GetCall(2)
+}
+
+// Call4Vx1 is the type-safe representation of a call to a function
+// with four arguments, the last of which is variadic, and one return value
+type Call4Vx1[A0, A1, A2, A3, R0 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call4Vx1[A0, A1, A2, A3, R0]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with
+func (c Call4Vx1[A0, A1, A2, A3, R0]) Arg1() A1 {
+	return valueAt[A1](c.args, 1)
+}
+
+// Arg2 returns argument 2 the stub was called with
+func (c Call4Vx1[A0, A1, A2, A3, R0]) Arg2() A2 {
+	return valueAt[A2](c.args, 2)
+}
+
+// Arg3 returns argument 3 the stub was called with, the
+// variadic arguments as a slice
+func (c Call4Vx1[A0, A1, A2, A3, R0]) Arg3() []A3 {
+	return valueAt[[]A3](c.args, 3)
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call4Vx1[A0, A1, A2, A3, R0]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// Stub4Vx2 is a type-safe stub for functions with four arguments, the last of which is variadic, and two return values.
+type Stub4Vx2[A0, A1, A2, A3, R0, R1 any] struct {
+	returns2[func(A0, A1, A2, ...A3) (R0, R1), R0, R1]
+}
+
+// Function4Vx2 replaces the provided function, which has
+// four arguments, the last of which is variadic, and two return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function4Vx2[F ~func(A0, A1, A2, ...A3) (R0, R1), A0, A1, A2, A3, R0, R1 any](testReporter TestReporter, originalFuncPtr *F) *Stub4Vx2[A0, A1, A2, A3, R0, R1] {
+	return newStub4Vx2[A0, A1, A2, A3, R0, R1](Function(testReporter, originalFuncPtr))
+}
+
+// newStub4Vx2 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub4Vx2[A0, A1, A2, A3, R0, R1 any](stub *Stub) *Stub4Vx2[A0, A1, A2, A3, R0, R1] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub4Vx2[A0, A1, A2, A3, R0, R1]{returns2[func(A0, A1, A2, ...A3) (R0, R1), R0, R1]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub4Vx2[A0, A1, A2, A3, R0, R1]) WithArgs(a0 A0, a1 A1, a2 A2, a3 ...A3) *CustomArguments2[func(A0, A1, A2, ...A3) (R0, R1), R0, R1] {
+	return &CustomArguments2[func(A0, A1, A2, ...A3) (R0, R1), R0, R1]{s.Stub.WithArgs(appendVariadic([]interface{}{a0, a1, a2}, a3)...)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub4Vx2[A0, A1, A2, A3, R0, R1]) GetCalls() []Call4Vx2[A0, A1, A2, A3, R0, R1] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call4Vx2[A0, A1, A2, A3, R0, R1] { return Call4Vx2[A0, A1, A2, A3, R0, R1]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub4Vx2[A0, A1, A2, A3, R0, R1]) GetCall(callIndex int) Call4Vx2[A0, A1, A2, A3, R0, R1] {
+	return Call4Vx2[A0, A1, A2, A3, R0, R1]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub4Vx2[A0, A1, A2, A3, R0, R1]) GetFirstCall() Call4Vx2[A0, A1, A2, A3, R0, R1] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub4Vx2[A0, A1, A2, A3, R0, R1]) GetSecondCall() Call4Vx2[A0, A1, A2, A3, R0, R1] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub4Vx2[A0, A1, A2, A3, R0, R1]) GetThirdCall() Call4Vx2[A0, A1, A2, A3, R0, R1] {
+	return s.GetCall(2)
+}
+
+// Call4Vx2 is the type-safe representation of a call to a function
+// with four arguments, the last of which is variadic, and two return values
+type Call4Vx2[A0, A1, A2, A3, R0, R1 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call4Vx2[A0, A1, A2, A3, R0, R1]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with
+func (c Call4Vx2[A0, A1, A2, A3, R0, R1]) Arg1() A1 {
+	return valueAt[A1](c.args, 1)
+}
+
+// Arg2 returns argument 2 the stub was called with
+func (c Call4Vx2[A0, A1, A2, A3, R0, R1]) Arg2() A2 {
+	return valueAt[A2](c.args, 2)
+}
+
+// Arg3 returns argument 3 the stub was called with, the
+// variadic arguments as a slice
+func (c Call4Vx2[A0, A1, A2, A3, R0, R1]) Arg3() []A3 {
+	return valueAt[[]A3](c.args, 3)
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call4Vx2[A0, A1, A2, A3, R0, R1]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// ReturnValue1 returns return value 1 of the stubbed implementation
+func (c Call4Vx2[A0, A1, A2, A3, R0, R1]) ReturnValue1() R1 {
+	return valueAt[R1](c.out, 1)
+}
+
+// Stub4Vx3 is a type-safe stub for functions with four arguments, the last of which is variadic, and three return values.
+type Stub4Vx3[A0, A1, A2, A3, R0, R1, R2 any] struct {
+	returns3[func(A0, A1, A2, ...A3) (R0, R1, R2), R0, R1, R2]
+}
+
+// Function4Vx3 replaces the provided function, which has
+// four arguments, the last of which is variadic, and three return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function4Vx3[F ~func(A0, A1, A2, ...A3) (R0, R1, R2), A0, A1, A2, A3, R0, R1, R2 any](testReporter TestReporter, originalFuncPtr *F) *Stub4Vx3[A0, A1, A2, A3, R0, R1, R2] {
+	return newStub4Vx3[A0, A1, A2, A3, R0, R1, R2](Function(testReporter, originalFuncPtr))
+}
+
+// newStub4Vx3 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub4Vx3[A0, A1, A2, A3, R0, R1, R2 any](stub *Stub) *Stub4Vx3[A0, A1, A2, A3, R0, R1, R2] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub4Vx3[A0, A1, A2, A3, R0, R1, R2]{returns3[func(A0, A1, A2, ...A3) (R0, R1, R2), R0, R1, R2]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub4Vx3[A0, A1, A2, A3, R0, R1, R2]) WithArgs(a0 A0, a1 A1, a2 A2, a3 ...A3) *CustomArguments3[func(A0, A1, A2, ...A3) (R0, R1, R2), R0, R1, R2] {
+	return &CustomArguments3[func(A0, A1, A2, ...A3) (R0, R1, R2), R0, R1, R2]{s.Stub.WithArgs(appendVariadic([]interface{}{a0, a1, a2}, a3)...)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub4Vx3[A0, A1, A2, A3, R0, R1, R2]) GetCalls() []Call4Vx3[A0, A1, A2, A3, R0, R1, R2] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call4Vx3[A0, A1, A2, A3, R0, R1, R2] { return Call4Vx3[A0, A1, A2, A3, R0, R1, R2]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub4Vx3[A0, A1, A2, A3, R0, R1, R2]) GetCall(callIndex int) Call4Vx3[A0, A1, A2, A3, R0, R1, R2] {
+	return Call4Vx3[A0, A1, A2, A3, R0, R1, R2]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub4Vx3[A0, A1, A2, A3, R0, R1, R2]) GetFirstCall() Call4Vx3[A0, A1, A2, A3, R0, R1, R2] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub4Vx3[A0, A1, A2, A3, R0, R1, R2]) GetSecondCall() Call4Vx3[A0, A1, A2, A3, R0, R1, R2] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub4Vx3[A0, A1, A2, A3, R0, R1, R2]) GetThirdCall() Call4Vx3[A0, A1, A2, A3, R0, R1, R2] {
+	return s.GetCall(2)
+}
+
+// Call4Vx3 is the type-safe representation of a call to a function
+// with four arguments, the last of which is variadic, and three return values
+type Call4Vx3[A0, A1, A2, A3, R0, R1, R2 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call4Vx3[A0, A1, A2, A3, R0, R1, R2]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with
+func (c Call4Vx3[A0, A1, A2, A3, R0, R1, R2]) Arg1() A1 {
+	return valueAt[A1](c.args, 1)
+}
+
+// Arg2 returns argument 2 the stub was called with
+func (c Call4Vx3[A0, A1, A2, A3, R0, R1, R2]) Arg2() A2 {
+	return valueAt[A2](c.args, 2)
+}
+
+// Arg3 returns argument 3 the stub was called with, the
+// variadic arguments as a slice
+func (c Call4Vx3[A0, A1, A2, A3, R0, R1, R2]) Arg3() []A3 {
+	return valueAt[[]A3](c.args, 3)
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call4Vx3[A0, A1, A2, A3, R0, R1, R2]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// ReturnValue1 returns return value 1 of the stubbed implementation
+func (c Call4Vx3[A0, A1, A2, A3, R0, R1, R2]) ReturnValue1() R1 {
+	return valueAt[R1](c.out, 1)
+}
+
+// ReturnValue2 returns return value 2 of the stubbed implementation
+func (c Call4Vx3[A0, A1, A2, A3, R0, R1, R2]) ReturnValue2() R2 {
+	return valueAt[R2](c.out, 2)
+}
+
+// Stub5x0 is a type-safe stub for functions with five arguments and no return values.
+type Stub5x0[A0, A1, A2, A3, A4 any] struct {
+	returns0[func(A0, A1, A2, A3, A4)]
+}
+
+// Function5x0 replaces the provided function, which has
+// five arguments and no return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function5x0[F ~func(A0, A1, A2, A3, A4), A0, A1, A2, A3, A4 any](testReporter TestReporter, originalFuncPtr *F) *Stub5x0[A0, A1, A2, A3, A4] {
+	return newStub5x0[A0, A1, A2, A3, A4](Function(testReporter, originalFuncPtr))
+}
+
+// newStub5x0 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub5x0[A0, A1, A2, A3, A4 any](stub *Stub) *Stub5x0[A0, A1, A2, A3, A4] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub5x0[A0, A1, A2, A3, A4]{returns0[func(A0, A1, A2, A3, A4)]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub5x0[A0, A1, A2, A3, A4]) WithArgs(a0 A0, a1 A1, a2 A2, a3 A3, a4 A4) *CustomArguments0[func(A0, A1, A2, A3, A4)] {
+	return &CustomArguments0[func(A0, A1, A2, A3, A4)]{s.Stub.WithArgs(a0, a1, a2, a3, a4)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub5x0[A0, A1, A2, A3, A4]) GetCalls() []Call5x0[A0, A1, A2, A3, A4] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call5x0[A0, A1, A2, A3, A4] { return Call5x0[A0, A1, A2, A3, A4]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub5x0[A0, A1, A2, A3, A4]) GetCall(callIndex int) Call5x0[A0, A1, A2, A3, A4] {
+	return Call5x0[A0, A1, A2, A3, A4]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub5x0[A0, A1, A2, A3, A4]) GetFirstCall() Call5x0[A0, A1, A2, A3, A4] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub5x0[A0, A1, A2, A3, A4]) GetSecondCall() Call5x0[A0, A1, A2, A3, A4] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub5x0[A0, A1, A2, A3, A4]) GetThirdCall() Call5x0[A0, A1, A2, A3, A4] {
+	return s.GetCall(2)
+}
+
+// Call5x0 is the type-safe representation of a call to a function
+// with five arguments and no return values
+type Call5x0[A0, A1, A2, A3, A4 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call5x0[A0, A1, A2, A3, A4]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with
+func (c Call5x0[A0, A1, A2, A3, A4]) Arg1() A1 {
+	return valueAt[A1](c.args, 1)
+}
+
+// Arg2 returns argument 2 the stub was called with
+func (c Call5x0[A0, A1, A2, A3, A4]) Arg2() A2 {
+	return valueAt[A2](c.args, 2)
+}
+
+// Arg3 returns argument 3 the stub was called with
+func (c Call5x0[A0, A1, A2, A3, A4]) Arg3() A3 {
+	return valueAt[A3](c.args, 3)
+}
+
+// Arg4 returns argument 4 the stub was called with
+func (c Call5x0[A0, A1, A2, A3, A4]) Arg4() A4 {
+	return valueAt[A4](c.args, 4)
+}
+
+// Stub5x1 is a type-safe stub for functions with five arguments and one return value.
+type Stub5x1[A0, A1, A2, A3, A4, R0 any] struct {
+	returns1[func(A0, A1, A2, A3, A4) R0, R0]
+}
+
+// Function5x1 replaces the provided function, which has
+// five arguments and one return value, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function5x1[F ~func(A0, A1, A2, A3, A4) R0, A0, A1, A2, A3, A4, R0 any](testReporter TestReporter, originalFuncPtr *F) *Stub5x1[A0, A1, A2, A3, A4, R0] {
+	return newStub5x1[A0, A1, A2, A3, A4, R0](Function(testReporter, originalFuncPtr))
+}
+
+// newStub5x1 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub5x1[A0, A1, A2, A3, A4, R0 any](stub *Stub) *Stub5x1[A0, A1, A2, A3, A4, R0] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub5x1[A0, A1, A2, A3, A4, R0]{returns1[func(A0, A1, A2, A3, A4) R0, R0]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub5x1[A0, A1, A2, A3, A4, R0]) WithArgs(a0 A0, a1 A1, a2 A2, a3 A3, a4 A4) *CustomArguments1[func(A0, A1, A2, A3, A4) R0, R0] {
+	return &CustomArguments1[func(A0, A1, A2, A3, A4) R0, R0]{s.Stub.WithArgs(a0, a1, a2, a3, a4)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub5x1[A0, A1, A2, A3, A4, R0]) GetCalls() []Call5x1[A0, A1, A2, A3, A4, R0] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call5x1[A0, A1, A2, A3, A4, R0] { return Call5x1[A0, A1, A2, A3, A4, R0]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub5x1[A0, A1, A2, A3, A4, R0]) GetCall(callIndex int) Call5x1[A0, A1, A2, A3, A4, R0] {
+	return Call5x1[A0, A1, A2, A3, A4, R0]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub5x1[A0, A1, A2, A3, A4, R0]) GetFirstCall() Call5x1[A0, A1, A2, A3, A4, R0] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub5x1[A0, A1, A2, A3, A4, R0]) GetSecondCall() Call5x1[A0, A1, A2, A3, A4, R0] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub5x1[A0, A1, A2, A3, A4, R0]) GetThirdCall() Call5x1[A0, A1, A2, A3, A4, R0] {
+	return s.GetCall(2)
+}
+
+// Call5x1 is the type-safe representation of a call to a function
+// with five arguments and one return value
+type Call5x1[A0, A1, A2, A3, A4, R0 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call5x1[A0, A1, A2, A3, A4, R0]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with
+func (c Call5x1[A0, A1, A2, A3, A4, R0]) Arg1() A1 {
+	return valueAt[A1](c.args, 1)
+}
+
+// Arg2 returns argument 2 the stub was called with
+func (c Call5x1[A0, A1, A2, A3, A4, R0]) Arg2() A2 {
+	return valueAt[A2](c.args, 2)
+}
+
+// Arg3 returns argument 3 the stub was called with
+func (c Call5x1[A0, A1, A2, A3, A4, R0]) Arg3() A3 {
+	return valueAt[A3](c.args, 3)
+}
+
+// Arg4 returns argument 4 the stub was called with
+func (c Call5x1[A0, A1, A2, A3, A4, R0]) Arg4() A4 {
+	return valueAt[A4](c.args, 4)
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call5x1[A0, A1, A2, A3, A4, R0]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// Stub5x2 is a type-safe stub for functions with five arguments and two return values.
+type Stub5x2[A0, A1, A2, A3, A4, R0, R1 any] struct {
+	returns2[func(A0, A1, A2, A3, A4) (R0, R1), R0, R1]
+}
+
+// Function5x2 replaces the provided function, which has
+// five arguments and two return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function5x2[F ~func(A0, A1, A2, A3, A4) (R0, R1), A0, A1, A2, A3, A4, R0, R1 any](testReporter TestReporter, originalFuncPtr *F) *Stub5x2[A0, A1, A2, A3, A4, R0, R1] {
+	return newStub5x2[A0, A1, A2, A3, A4, R0, R1](Function(testReporter, originalFuncPtr))
+}
+
+// newStub5x2 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub5x2[A0, A1, A2, A3, A4, R0, R1 any](stub *Stub) *Stub5x2[A0, A1, A2, A3, A4, R0, R1] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub5x2[A0, A1, A2, A3, A4, R0, R1]{returns2[func(A0, A1, A2, A3, A4) (R0, R1), R0, R1]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub5x2[A0, A1, A2, A3, A4, R0, R1]) WithArgs(a0 A0, a1 A1, a2 A2, a3 A3, a4 A4) *CustomArguments2[func(A0, A1, A2, A3, A4) (R0, R1), R0, R1] {
+	return &CustomArguments2[func(A0, A1, A2, A3, A4) (R0, R1), R0, R1]{s.Stub.WithArgs(a0, a1, a2, a3, a4)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub5x2[A0, A1, A2, A3, A4, R0, R1]) GetCalls() []Call5x2[A0, A1, A2, A3, A4, R0, R1] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call5x2[A0, A1, A2, A3, A4, R0, R1] { return Call5x2[A0, A1, A2, A3, A4, R0, R1]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub5x2[A0, A1, A2, A3, A4, R0, R1]) GetCall(callIndex int) Call5x2[A0, A1, A2, A3, A4, R0, R1] {
+	return Call5x2[A0, A1, A2, A3, A4, R0, R1]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub5x2[A0, A1, A2, A3, A4, R0, R1]) GetFirstCall() Call5x2[A0, A1, A2, A3, A4, R0, R1] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub5x2[A0, A1, A2, A3, A4, R0, R1]) GetSecondCall() Call5x2[A0, A1, A2, A3, A4, R0, R1] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub5x2[A0, A1, A2, A3, A4, R0, R1]) GetThirdCall() Call5x2[A0, A1, A2, A3, A4, R0, R1] {
+	return s.GetCall(2)
+}
+
+// Call5x2 is the type-safe representation of a call to a function
+// with five arguments and two return values
+type Call5x2[A0, A1, A2, A3, A4, R0, R1 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call5x2[A0, A1, A2, A3, A4, R0, R1]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with
+func (c Call5x2[A0, A1, A2, A3, A4, R0, R1]) Arg1() A1 {
+	return valueAt[A1](c.args, 1)
+}
+
+// Arg2 returns argument 2 the stub was called with
+func (c Call5x2[A0, A1, A2, A3, A4, R0, R1]) Arg2() A2 {
+	return valueAt[A2](c.args, 2)
+}
+
+// Arg3 returns argument 3 the stub was called with
+func (c Call5x2[A0, A1, A2, A3, A4, R0, R1]) Arg3() A3 {
+	return valueAt[A3](c.args, 3)
+}
+
+// Arg4 returns argument 4 the stub was called with
+func (c Call5x2[A0, A1, A2, A3, A4, R0, R1]) Arg4() A4 {
+	return valueAt[A4](c.args, 4)
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call5x2[A0, A1, A2, A3, A4, R0, R1]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// ReturnValue1 returns return value 1 of the stubbed implementation
+func (c Call5x2[A0, A1, A2, A3, A4, R0, R1]) ReturnValue1() R1 {
+	return valueAt[R1](c.out, 1)
+}
+
+// Stub5x3 is a type-safe stub for functions with five arguments and three return values.
+type Stub5x3[A0, A1, A2, A3, A4, R0, R1, R2 any] struct {
+	returns3[func(A0, A1, A2, A3, A4) (R0, R1, R2), R0, R1, R2]
+}
+
+// Function5x3 replaces the provided function, which has
+// five arguments and three return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function5x3[F ~func(A0, A1, A2, A3, A4) (R0, R1, R2), A0, A1, A2, A3, A4, R0, R1, R2 any](testReporter TestReporter, originalFuncPtr *F) *Stub5x3[A0, A1, A2, A3, A4, R0, R1, R2] {
+	return newStub5x3[A0, A1, A2, A3, A4, R0, R1, R2](Function(testReporter, originalFuncPtr))
+}
+
+// newStub5x3 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub5x3[A0, A1, A2, A3, A4, R0, R1, R2 any](stub *Stub) *Stub5x3[A0, A1, A2, A3, A4, R0, R1, R2] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub5x3[A0, A1, A2, A3, A4, R0, R1, R2]{returns3[func(A0, A1, A2, A3, A4) (R0, R1, R2), R0, R1, R2]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub5x3[A0, A1, A2, A3, A4, R0, R1, R2]) WithArgs(a0 A0, a1 A1, a2 A2, a3 A3, a4 A4) *CustomArguments3[func(A0, A1, A2, A3, A4) (R0, R1, R2), R0, R1, R2] {
+	return &CustomArguments3[func(A0, A1, A2, A3, A4) (R0, R1, R2), R0, R1, R2]{s.Stub.WithArgs(a0, a1, a2, a3, a4)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub5x3[A0, A1, A2, A3, A4, R0, R1, R2]) GetCalls() []Call5x3[A0, A1, A2, A3, A4, R0, R1, R2] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call5x3[A0, A1, A2, A3, A4, R0, R1, R2] {
+		return Call5x3[A0, A1, A2, A3, A4, R0, R1, R2]{c}
+	})
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub5x3[A0, A1, A2, A3, A4, R0, R1, R2]) GetCall(callIndex int) Call5x3[A0, A1, A2, A3, A4, R0, R1, R2] {
+	return Call5x3[A0, A1, A2, A3, A4, R0, R1, R2]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub5x3[A0, A1, A2, A3, A4, R0, R1, R2]) GetFirstCall() Call5x3[A0, A1, A2, A3, A4, R0, R1, R2] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub5x3[A0, A1, A2, A3, A4, R0, R1, R2]) GetSecondCall() Call5x3[A0, A1, A2, A3, A4, R0, R1, R2] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub5x3[A0, A1, A2, A3, A4, R0, R1, R2]) GetThirdCall() Call5x3[A0, A1, A2, A3, A4, R0, R1, R2] {
+	return s.GetCall(2)
+}
+
+// Call5x3 is the type-safe representation of a call to a function
+// with five arguments and three return values
+type Call5x3[A0, A1, A2, A3, A4, R0, R1, R2 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call5x3[A0, A1, A2, A3, A4, R0, R1, R2]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with
+func (c Call5x3[A0, A1, A2, A3, A4, R0, R1, R2]) Arg1() A1 {
+	return valueAt[A1](c.args, 1)
+}
+
+// Arg2 returns argument 2 the stub was called with
+func (c Call5x3[A0, A1, A2, A3, A4, R0, R1, R2]) Arg2() A2 {
+	return valueAt[A2](c.args, 2)
+}
+
+// Arg3 returns argument 3 the stub was called with
+func (c Call5x3[A0, A1, A2, A3, A4, R0, R1, R2]) Arg3() A3 {
+	return valueAt[A3](c.args, 3)
+}
+
+// Arg4 returns argument 4 the stub was called with
+func (c Call5x3[A0, A1, A2, A3, A4, R0, R1, R2]) Arg4() A4 {
+	return valueAt[A4](c.args, 4)
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call5x3[A0, A1, A2, A3, A4, R0, R1, R2]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// ReturnValue1 returns return value 1 of the stubbed implementation
+func (c Call5x3[A0, A1, A2, A3, A4, R0, R1, R2]) ReturnValue1() R1 {
+	return valueAt[R1](c.out, 1)
+}
+
+// ReturnValue2 returns return value 2 of the stubbed implementation
+func (c Call5x3[A0, A1, A2, A3, A4, R0, R1, R2]) ReturnValue2() R2 {
+	return valueAt[R2](c.out, 2)
+}
+
+// Stub5Vx0 is a type-safe stub for functions with five arguments, the last of which is variadic, and no return values.
+type Stub5Vx0[A0, A1, A2, A3, A4 any] struct {
+	returns0[func(A0, A1, A2, A3, ...A4)]
+}
+
+// Function5Vx0 replaces the provided function, which has
+// five arguments, the last of which is variadic, and no return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function5Vx0[F ~func(A0, A1, A2, A3, ...A4), A0, A1, A2, A3, A4 any](testReporter TestReporter, originalFuncPtr *F) *Stub5Vx0[A0, A1, A2, A3, A4] {
+	return newStub5Vx0[A0, A1, A2, A3, A4](Function(testReporter, originalFuncPtr))
+}
+
+// newStub5Vx0 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub5Vx0[A0, A1, A2, A3, A4 any](stub *Stub) *Stub5Vx0[A0, A1, A2, A3, A4] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub5Vx0[A0, A1, A2, A3, A4]{returns0[func(A0, A1, A2, A3, ...A4)]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub5Vx0[A0, A1, A2, A3, A4]) WithArgs(a0 A0, a1 A1, a2 A2, a3 A3, a4 ...A4) *CustomArguments0[func(A0, A1, A2, A3, ...A4)] {
+	return &CustomArguments0[func(A0, A1, A2, A3, ...A4)]{s.Stub.WithArgs(appendVariadic([]interface{}{a0, a1, a2, a3}, a4)...)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub5Vx0[A0, A1, A2, A3, A4]) GetCalls() []Call5Vx0[A0, A1, A2, A3, A4] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call5Vx0[A0, A1, A2, A3, A4] { return Call5Vx0[A0, A1, A2, A3, A4]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub5Vx0[A0, A1, A2, A3, A4]) GetCall(callIndex int) Call5Vx0[A0, A1, A2, A3, A4] {
+	return Call5Vx0[A0, A1, A2, A3, A4]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub5Vx0[A0, A1, A2, A3, A4]) GetFirstCall() Call5Vx0[A0, A1, A2, A3, A4] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub5Vx0[A0, A1, A2, A3, A4]) GetSecondCall() Call5Vx0[A0, A1, A2, A3, A4] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub5Vx0[A0, A1, A2, A3, A4]) GetThirdCall() Call5Vx0[A0, A1, A2, A3, A4] {
+	return s.GetCall(2)
+}
+
+// Call5Vx0 is the type-safe representation of a call to a function
+// with five arguments, the last of which is variadic, and no return values
+type Call5Vx0[A0, A1, A2, A3, A4 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call5Vx0[A0, A1, A2, A3, A4]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with
+func (c Call5Vx0[A0, A1, A2, A3, A4]) Arg1() A1 {
+	return valueAt[A1](c.args, 1)
+}
+
+// Arg2 returns argument 2 the stub was called with
+func (c Call5Vx0[A0, A1, A2, A3, A4]) Arg2() A2 {
+	return valueAt[A2](c.args, 2)
+}
+
+// Arg3 returns argument 3 the stub was called with
+func (c Call5Vx0[A0, A1, A2, A3, A4]) Arg3() A3 {
+	return valueAt[A3](c.args, 3)
+}
+
+// Arg4 returns argument 4 the stub was called with, the
+// variadic arguments as a slice
+func (c Call5Vx0[A0, A1, A2, A3, A4]) Arg4() []A4 {
+	return valueAt[[]A4](c.args, 4)
+}
+
+// Stub5Vx1 is a type-safe stub for functions with five arguments, the last of which is variadic, and one return value.
+type Stub5Vx1[A0, A1, A2, A3, A4, R0 any] struct {
+	returns1[func(A0, A1, A2, A3, ...A4) R0, R0]
+}
+
+// Function5Vx1 replaces the provided function, which has
+// five arguments, the last of which is variadic, and one return value, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function5Vx1[F ~func(A0, A1, A2, A3, ...A4) R0, A0, A1, A2, A3, A4, R0 any](testReporter TestReporter, originalFuncPtr *F) *Stub5Vx1[A0, A1, A2, A3, A4, R0] {
+	return newStub5Vx1[A0, A1, A2, A3, A4, R0](Function(testReporter, originalFuncPtr))
+}
+
+// newStub5Vx1 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub5Vx1[A0, A1, A2, A3, A4, R0 any](stub *Stub) *Stub5Vx1[A0, A1, A2, A3, A4, R0] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub5Vx1[A0, A1, A2, A3, A4, R0]{returns1[func(A0, A1, A2, A3, ...A4) R0, R0]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub5Vx1[A0, A1, A2, A3, A4, R0]) WithArgs(a0 A0, a1 A1, a2 A2, a3 A3, a4 ...A4) *CustomArguments1[func(A0, A1, A2, A3, ...A4) R0, R0] {
+	return &CustomArguments1[func(A0, A1, A2, A3, ...A4) R0, R0]{s.Stub.WithArgs(appendVariadic([]interface{}{a0, a1, a2, a3}, a4)...)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub5Vx1[A0, A1, A2, A3, A4, R0]) GetCalls() []Call5Vx1[A0, A1, A2, A3, A4, R0] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call5Vx1[A0, A1, A2, A3, A4, R0] { return Call5Vx1[A0, A1, A2, A3, A4, R0]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub5Vx1[A0, A1, A2, A3, A4, R0]) GetCall(callIndex int) Call5Vx1[A0, A1, A2, A3, A4, R0] {
+	return Call5Vx1[A0, A1, A2, A3, A4, R0]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub5Vx1[A0, A1, A2, A3, A4, R0]) GetFirstCall() Call5Vx1[A0, A1, A2, A3, A4, R0] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub5Vx1[A0, A1, A2, A3, A4, R0]) GetSecondCall() Call5Vx1[A0, A1, A2, A3, A4, R0] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub5Vx1[A0, A1, A2, A3, A4, R0]) GetThirdCall() Call5Vx1[A0, A1, A2, A3, A4, R0] {
+	return s.GetCall(2)
+}
+
+// Call5Vx1 is the type-safe representation of a call to a function
+// with five arguments, the last of which is variadic, and one return value
+type Call5Vx1[A0, A1, A2, A3, A4, R0 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call5Vx1[A0, A1, A2, A3, A4, R0]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with
+func (c Call5Vx1[A0, A1, A2, A3, A4, R0]) Arg1() A1 {
+	return valueAt[A1](c.args, 1)
+}
+
+// Arg2 returns argument 2 the stub was called with
+func (c Call5Vx1[A0, A1, A2, A3, A4, R0]) Arg2() A2 {
+	return valueAt[A2](c.args, 2)
+}
+
+// Arg3 returns argument 3 the stub was called with
+func (c Call5Vx1[A0, A1, A2, A3, A4, R0]) Arg3() A3 {
+	return valueAt[A3](c.args, 3)
+}
+
+// Arg4 returns argument 4 the stub was called with, the
+// variadic arguments as a slice
+func (c Call5Vx1[A0, A1, A2, A3, A4, R0]) Arg4() []A4 {
+	return valueAt[[]A4](c.args, 4)
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call5Vx1[A0, A1, A2, A3, A4, R0]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// Stub5Vx2 is a type-safe stub for functions with five arguments, the last of which is variadic, and two return values.
+type Stub5Vx2[A0, A1, A2, A3, A4, R0, R1 any] struct {
+	returns2[func(A0, A1, A2, A3, ...A4) (R0, R1), R0, R1]
+}
+
+// Function5Vx2 replaces the provided function, which has
+// five arguments, the last of which is variadic, and two return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function5Vx2[F ~func(A0, A1, A2, A3, ...A4) (R0, R1), A0, A1, A2, A3, A4, R0, R1 any](testReporter TestReporter, originalFuncPtr *F) *Stub5Vx2[A0, A1, A2, A3, A4, R0, R1] {
+	return newStub5Vx2[A0, A1, A2, A3, A4, R0, R1](Function(testReporter, originalFuncPtr))
+}
+
+// newStub5Vx2 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub5Vx2[A0, A1, A2, A3, A4, R0, R1 any](stub *Stub) *Stub5Vx2[A0, A1, A2, A3, A4, R0, R1] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub5Vx2[A0, A1, A2, A3, A4, R0, R1]{returns2[func(A0, A1, A2, A3, ...A4) (R0, R1), R0, R1]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub5Vx2[A0, A1, A2, A3, A4, R0, R1]) WithArgs(a0 A0, a1 A1, a2 A2, a3 A3, a4 ...A4) *CustomArguments2[func(A0, A1, A2, A3, ...A4) (R0, R1), R0, R1] {
+	return &CustomArguments2[func(A0, A1, A2, A3, ...A4) (R0, R1), R0, R1]{s.Stub.WithArgs(appendVariadic([]interface{}{a0, a1, a2, a3}, a4)...)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub5Vx2[A0, A1, A2, A3, A4, R0, R1]) GetCalls() []Call5Vx2[A0, A1, A2, A3, A4, R0, R1] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call5Vx2[A0, A1, A2, A3, A4, R0, R1] { return Call5Vx2[A0, A1, A2, A3, A4, R0, R1]{c} })
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub5Vx2[A0, A1, A2, A3, A4, R0, R1]) GetCall(callIndex int) Call5Vx2[A0, A1, A2, A3, A4, R0, R1] {
+	return Call5Vx2[A0, A1, A2, A3, A4, R0, R1]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub5Vx2[A0, A1, A2, A3, A4, R0, R1]) GetFirstCall() Call5Vx2[A0, A1, A2, A3, A4, R0, R1] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub5Vx2[A0, A1, A2, A3, A4, R0, R1]) GetSecondCall() Call5Vx2[A0, A1, A2, A3, A4, R0, R1] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub5Vx2[A0, A1, A2, A3, A4, R0, R1]) GetThirdCall() Call5Vx2[A0, A1, A2, A3, A4, R0, R1] {
+	return s.GetCall(2)
+}
+
+// Call5Vx2 is the type-safe representation of a call to a function
+// with five arguments, the last of which is variadic, and two return values
+type Call5Vx2[A0, A1, A2, A3, A4, R0, R1 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call5Vx2[A0, A1, A2, A3, A4, R0, R1]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with
+func (c Call5Vx2[A0, A1, A2, A3, A4, R0, R1]) Arg1() A1 {
+	return valueAt[A1](c.args, 1)
+}
+
+// Arg2 returns argument 2 the stub was called with
+func (c Call5Vx2[A0, A1, A2, A3, A4, R0, R1]) Arg2() A2 {
+	return valueAt[A2](c.args, 2)
+}
+
+// Arg3 returns argument 3 the stub was called with
+func (c Call5Vx2[A0, A1, A2, A3, A4, R0, R1]) Arg3() A3 {
+	return valueAt[A3](c.args, 3)
+}
+
+// Arg4 returns argument 4 the stub was called with, the
+// variadic arguments as a slice
+func (c Call5Vx2[A0, A1, A2, A3, A4, R0, R1]) Arg4() []A4 {
+	return valueAt[[]A4](c.args, 4)
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call5Vx2[A0, A1, A2, A3, A4, R0, R1]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// ReturnValue1 returns return value 1 of the stubbed implementation
+func (c Call5Vx2[A0, A1, A2, A3, A4, R0, R1]) ReturnValue1() R1 {
+	return valueAt[R1](c.out, 1)
+}
+
+// Stub5Vx3 is a type-safe stub for functions with five arguments, the last of which is variadic, and three return values.
+type Stub5Vx3[A0, A1, A2, A3, A4, R0, R1, R2 any] struct {
+	returns3[func(A0, A1, A2, A3, ...A4) (R0, R1, R2), R0, R1, R2]
+}
+
+// Function5Vx3 replaces the provided function, which has
+// five arguments, the last of which is variadic, and three return values, with a type-safe
+// stub. The function can be of a named function type. The stub returns the
+// zero values of the function's return types until told otherwise.
+func Function5Vx3[F ~func(A0, A1, A2, A3, ...A4) (R0, R1, R2), A0, A1, A2, A3, A4, R0, R1, R2 any](testReporter TestReporter, originalFuncPtr *F) *Stub5Vx3[A0, A1, A2, A3, A4, R0, R1, R2] {
+	return newStub5Vx3[A0, A1, A2, A3, A4, R0, R1, R2](Function(testReporter, originalFuncPtr))
+}
+
+// newStub5Vx3 wraps the reflection based stub in a type-safe stub.
+// It returns nil if the stub could not be created.
+func newStub5Vx3[A0, A1, A2, A3, A4, R0, R1, R2 any](stub *Stub) *Stub5Vx3[A0, A1, A2, A3, A4, R0, R1, R2] {
+	if stub == nil {
+		return nil
+	}
+
+	return &Stub5Vx3[A0, A1, A2, A3, A4, R0, R1, R2]{returns3[func(A0, A1, A2, A3, ...A4) (R0, R1, R2), R0, R1, R2]{stub}}
+}
+
+// WithArgs returns type-safe custom arguments that can change the out
+// parameters returned based on the arguments provided to this function
+func (s *Stub5Vx3[A0, A1, A2, A3, A4, R0, R1, R2]) WithArgs(a0 A0, a1 A1, a2 A2, a3 A3, a4 ...A4) *CustomArguments3[func(A0, A1, A2, A3, ...A4) (R0, R1, R2), R0, R1, R2] {
+	return &CustomArguments3[func(A0, A1, A2, A3, ...A4) (R0, R1, R2), R0, R1, R2]{s.Stub.WithArgs(appendVariadic([]interface{}{a0, a1, a2, a3}, a4)...)}
+}
+
+// GetCalls returns all calls made to the original function that were
+// captured by the stubbed implementation
+func (s *Stub5Vx3[A0, A1, A2, A3, A4, R0, R1, R2]) GetCalls() []Call5Vx3[A0, A1, A2, A3, A4, R0, R1, R2] {
+	return mapCalls(s.Stub.GetCalls(), func(c Call) Call5Vx3[A0, A1, A2, A3, A4, R0, R1, R2] {
+		return Call5Vx3[A0, A1, A2, A3, A4, R0, R1, R2]{c}
+	})
+}
+
+// GetCall returns the arguments and return values of the original function
+// that was captured by the stubbed implementation for the specified call index.
+func (s *Stub5Vx3[A0, A1, A2, A3, A4, R0, R1, R2]) GetCall(callIndex int) Call5Vx3[A0, A1, A2, A3, A4, R0, R1, R2] {
+	return Call5Vx3[A0, A1, A2, A3, A4, R0, R1, R2]{s.Stub.GetCall(callIndex)}
+}
+
+// GetFirstCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the first call.
+func (s *Stub5Vx3[A0, A1, A2, A3, A4, R0, R1, R2]) GetFirstCall() Call5Vx3[A0, A1, A2, A3, A4, R0, R1, R2] {
+	return s.GetCall(0)
+}
+
+// GetSecondCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the second call.
+func (s *Stub5Vx3[A0, A1, A2, A3, A4, R0, R1, R2]) GetSecondCall() Call5Vx3[A0, A1, A2, A3, A4, R0, R1, R2] {
+	return s.GetCall(1)
+}
+
+// GetThirdCall returns the arguments and return values of the original
+// function that was captured by the stubbed implementation for the third call.
+func (s *Stub5Vx3[A0, A1, A2, A3, A4, R0, R1, R2]) GetThirdCall() Call5Vx3[A0, A1, A2, A3, A4, R0, R1, R2] {
+	return s.GetCall(2)
+}
+
+// Call5Vx3 is the type-safe representation of a call to a function
+// with five arguments, the last of which is variadic, and three return values
+type Call5Vx3[A0, A1, A2, A3, A4, R0, R1, R2 any] struct {
+	Call
+}
+
+// Arg0 returns argument 0 the stub was called with
+func (c Call5Vx3[A0, A1, A2, A3, A4, R0, R1, R2]) Arg0() A0 {
+	return valueAt[A0](c.args, 0)
+}
+
+// Arg1 returns argument 1 the stub was called with
+func (c Call5Vx3[A0, A1, A2, A3, A4, R0, R1, R2]) Arg1() A1 {
+	return valueAt[A1](c.args, 1)
+}
+
+// Arg2 returns argument 2 the stub was called with
+func (c Call5Vx3[A0, A1, A2, A3, A4, R0, R1, R2]) Arg2() A2 {
+	return valueAt[A2](c.args, 2)
+}
+
+// Arg3 returns argument 3 the stub was called with
+func (c Call5Vx3[A0, A1, A2, A3, A4, R0, R1, R2]) Arg3() A3 {
+	return valueAt[A3](c.args, 3)
+}
+
+// Arg4 returns argument 4 the stub was called with, the
+// variadic arguments as a slice
+func (c Call5Vx3[A0, A1, A2, A3, A4, R0, R1, R2]) Arg4() []A4 {
+	return valueAt[[]A4](c.args, 4)
+}
+
+// ReturnValue0 returns return value 0 of the stubbed implementation
+func (c Call5Vx3[A0, A1, A2, A3, A4, R0, R1, R2]) ReturnValue0() R0 {
+	return valueAt[R0](c.out, 0)
+}
+
+// ReturnValue1 returns return value 1 of the stubbed implementation
+func (c Call5Vx3[A0, A1, A2, A3, A4, R0, R1, R2]) ReturnValue1() R1 {
+	return valueAt[R1](c.out, 1)
+}
+
+// ReturnValue2 returns return value 2 of the stubbed implementation
+func (c Call5Vx3[A0, A1, A2, A3, A4, R0, R1, R2]) ReturnValue2() R2 {
+	return valueAt[R2](c.out, 2)
+}
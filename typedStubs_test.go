@@ -0,0 +1,249 @@
+package mocka
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MonsantoCo/mocka/v2/match"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("typedStubs", func() {
+	var failTestReporter *mockTestReporter
+
+	BeforeEach(func() {
+		failTestReporter = &mockTestReporter{}
+	})
+
+	Describe("Function0x0", func() {
+		It("stubs the function and records the calls", func() {
+			called := false
+			fn := func() { called = true }
+
+			stub := Function0x0(GinkgoT(), &fn)
+			defer stub.Restore()
+
+			fn()
+
+			Expect(called).To(BeFalse())
+			Expect(stub.CallCount()).To(Equal(1))
+			Expect(stub.GetFirstCall().Arguments()).To(BeEmpty())
+		})
+	})
+
+	Describe("Function1x1", func() {
+		It("returns the zero values until told otherwise", func() {
+			fn := func(str string) int { return len(str) }
+
+			stub := Function1x1(GinkgoT(), &fn)
+			defer stub.Restore()
+
+			Expect(fn("hello")).To(Equal(0))
+
+			stub.Return(20)
+
+			Expect(fn("hello")).To(Equal(20))
+		})
+
+		It("returns a nil stub when the stub could not be created", func() {
+			stub := Function1x1[func(string) int](failTestReporter, nil)
+
+			Expect(stub).To(BeNil())
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: expected the second argument to be a pointer to a function, but received a nil",
+			}))
+		})
+	})
+
+	Describe("Function2x2", func() {
+		var fn func(string, int) (int, error)
+
+		BeforeEach(func() {
+			fn = func(str string, num int) (int, error) {
+				return len(str) + num, nil
+			}
+		})
+
+		It("changes the return values based on typed arguments", func() {
+			stub := Function2x2(GinkgoT(), &fn)
+			defer stub.Restore()
+
+			stub.WithArgs("hello", 2).Return(5, errors.New("Ope"))
+
+			n, err := fn("hello", 2)
+			Expect(n).To(Equal(5))
+			Expect(err).To(MatchError("Ope"))
+		})
+
+		It("returns typed calls", func() {
+			stub := Function2x2(GinkgoT(), &fn)
+			defer stub.Restore()
+			stub.Return(42, nil)
+
+			_, _ = fn("hello", 2)
+			_, _ = fn("world", 3)
+			_, _ = fn("!", 4)
+
+			Expect(stub.GetCalls()).To(HaveLen(3))
+
+			first := stub.GetFirstCall()
+			Expect(first.Arg0()).To(Equal("hello"))
+			Expect(first.Arg1()).To(Equal(2))
+			Expect(first.ReturnValue0()).To(Equal(42))
+			Expect(first.ReturnValue1()).To(BeNil())
+
+			Expect(stub.GetSecondCall().Arg0()).To(Equal("world"))
+			Expect(stub.GetThirdCall().Arg1()).To(Equal(4))
+		})
+	})
+
+	Describe("Function1Vx1", func() {
+		var scan func(...interface{}) error
+
+		BeforeEach(func() {
+			scan = func(dest ...interface{}) error {
+				return nil
+			}
+		})
+
+		It("changes the return values based on typed variadic arguments", func() {
+			stub := Function1Vx1(GinkgoT(), &scan)
+			defer stub.Restore()
+
+			stub.WithArgs("id").Return(errors.New("Ope"))
+
+			Expect(scan("id")).To(MatchError("Ope"))
+			Expect(scan("id", "name")).To(BeNil())
+			Expect(scan()).To(BeNil())
+		})
+
+		It("returns the variadic arguments of typed calls as a slice", func() {
+			stub := Function1Vx1(GinkgoT(), &scan)
+			defer stub.Restore()
+
+			_ = scan("id", "name")
+			_ = scan()
+
+			Expect(stub.GetFirstCall().Arg0()).To(Equal([]interface{}{"id", "name"}))
+			Expect(stub.GetSecondCall().Arg0()).To(BeEmpty())
+		})
+	})
+
+	Describe("Function2Vx1", func() {
+		It("computes the return values with a variadic function of the same signature", func() {
+			sprintf := func(format string, args ...interface{}) string { return "" }
+			stub := Function2Vx1(GinkgoT(), &sprintf)
+			defer stub.Restore()
+
+			stub.ReturnFunc(func(format string, args ...interface{}) string {
+				return fmt.Sprintf(format, args...)
+			})
+
+			Expect(sprintf("%v-%v", "a", 1)).To(Equal("a-1"))
+			Expect(sprintf("plain")).To(Equal("plain"))
+		})
+	})
+
+	Describe("Function2Vx2", func() {
+		It("computes the return values for matchers with a variadic function of the same signature", func() {
+			query := func(q string, args ...int) (int, error) { return 0, nil }
+			stub := Function2Vx2(GinkgoT(), &query)
+			defer stub.Restore()
+
+			stub.WithMatchers(match.StringPrefix("SELECT"), match.IntGreaterThan(0), match.Anything()).ReturnFunc(func(q string, args ...int) (int, error) {
+				return len(args), nil
+			})
+			stub.OnFirstCall().ReturnFunc(func(q string, args ...int) (int, error) {
+				return 0, errors.New(q)
+			})
+
+			_, err := query("UPDATE", 1)
+			n, _ := query("SELECT", 1, 2)
+
+			Expect(err).To(MatchError("UPDATE"))
+			Expect(n).To(Equal(2))
+		})
+	})
+
+	Describe("Function5x3", func() {
+		It("stubs functions with five arguments and three return values", func() {
+			fn := func(a string, b int, c bool, d float64, e []byte) (string, int, error) {
+				return a, b, nil
+			}
+			stub := Function5x3(GinkgoT(), &fn)
+			defer stub.Restore()
+
+			stub.WithArgs("a", 1, true, 2.5, []byte("e")).Return("stubbed", 2, errors.New("Ope"))
+
+			str, n, err := fn("a", 1, true, 2.5, []byte("e"))
+
+			Expect(str).To(Equal("stubbed"))
+			Expect(n).To(Equal(2))
+			Expect(err).To(MatchError("Ope"))
+
+			call := stub.GetFirstCall()
+			Expect(call.Arg4()).To(Equal([]byte("e")))
+			Expect(call.ReturnValue2()).To(MatchError("Ope"))
+		})
+	})
+
+	Describe("ReturnFunc", func() {
+		It("computes the return values with a function of the same signature", func() {
+			fn := func(str string, num int) (int, error) { return 0, nil }
+			stub := Function2x2(GinkgoT(), &fn)
+			defer stub.Restore()
+
+			stub.ReturnFunc(func(str string, num int) (int, error) {
+				return len(str) * num, nil
+			})
+
+			Expect(fn("hello", 2)).To(Equal(10))
+		})
+
+		It("computes the return values for custom arguments and call indexes with a function of the same signature", func() {
+			fn := func(str string) int { return 0 }
+			stub := Function1x1(GinkgoT(), &fn)
+			defer stub.Restore()
+
+			stub.WithArgs("a").ReturnFunc(func(str string) int { return 1 })
+			stub.OnSecondCall().ReturnFunc(func(str string) int { return len(str) })
+
+			Expect(fn("a")).To(Equal(1))
+			Expect(fn("hello")).To(Equal(5))
+			Expect(fn("hello")).To(Equal(0))
+		})
+	})
+
+	Describe("Function3x2", func() {
+		type lookup func(string, int, bool) (string, error)
+
+		It("stubs a function of a named function type", func() {
+			var fn lookup = func(a string, b int, c bool) (string, error) { return a, nil }
+
+			stub := Function3x2(GinkgoT(), &fn)
+			defer stub.Restore()
+			stub.WithArgs("a", 1, true).Return("stubbed", nil)
+
+			Expect(fn("a", 1, true)).To(Equal("stubbed"))
+			Expect(stub.GetCall(0).Arg2()).To(BeTrue())
+		})
+
+		It("is restored with a sandbox that adopted it", func() {
+			var fn lookup = func(a string, b int, c bool) (string, error) { return a, nil }
+			sandbox := CreateSandbox(GinkgoT())
+
+			stub := Function3x2(GinkgoT(), &fn)
+			sandbox.Adopt(stub.Stub)
+			stub.Return("stubbed", nil)
+
+			Expect(fn("b", 1, true)).To(Equal("stubbed"))
+			Expect(sandbox.stubs).To(Equal([]*Stub{stub.Stub}))
+
+			sandbox.Restore()
+
+			Expect(fn("b", 1, true)).To(Equal("b"))
+		})
+	})
+})
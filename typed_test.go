@@ -0,0 +1,132 @@
+package mocka
+
+import (
+	"errors"
+
+	"github.com/MonsantoCo/mocka/v2/match"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("typed", func() {
+	var fn func(string, int) (int, error)
+
+	BeforeEach(func() {
+		fn = func(str string, num int) (int, error) {
+			return len(str) + num, nil
+		}
+	})
+
+	Describe("returns", func() {
+		It("updates the default out parameters of the stub", func() {
+			stub := Function2x2(GinkgoT(), &fn)
+			defer stub.Restore()
+
+			stub.Return(42, errors.New("Ope"))
+
+			Expect(stub.Stub.outParameters).To(Equal([]interface{}{42, errors.New("Ope")}))
+		})
+
+		It("returns type-safe custom arguments for matchers", func() {
+			stub := Function2x2(GinkgoT(), &fn)
+			defer stub.Restore()
+
+			stub.WithMatchers(match.StringPrefix("app"), match.Anything()).Return(7, nil)
+
+			Expect(fn("apple", 0)).To(Equal(7))
+			Expect(fn("banana", 0)).To(Equal(0))
+		})
+
+		It("returns type-safe OnCalls for the call index", func() {
+			stub := Function2x2(GinkgoT(), &fn)
+			defer stub.Restore()
+
+			stub.OnFirstCall().Return(1, nil)
+			stub.OnSecondCall().Return(2, nil)
+			stub.OnThirdCall().Return(3, nil)
+
+			Expect(fn("", 0)).To(Equal(1))
+			Expect(fn("", 0)).To(Equal(2))
+			Expect(fn("", 0)).To(Equal(3))
+			Expect(fn("", 0)).To(Equal(0))
+		})
+	})
+
+	Describe("CustomArguments", func() {
+		It("sets the return values for the set of custom arguments", func() {
+			stub := Function2x2(GinkgoT(), &fn)
+			defer stub.Restore()
+
+			ca := stub.WithArgs("hello", 1)
+			ca.Return(10, nil)
+
+			Expect(ca.out).To(Equal([]interface{}{10, nil}))
+		})
+
+		It("returns type-safe OnCalls for the call index", func() {
+			stub := Function2x2(GinkgoT(), &fn)
+			defer stub.Restore()
+
+			ca := stub.WithArgs("hello", 1)
+			ca.OnFirstCall().Return(1, nil)
+			ca.OnSecondCall().Return(2, nil)
+			ca.OnThirdCall().Return(3, errors.New("Ope"))
+
+			Expect(fn("hello", 1)).To(Equal(1))
+			Expect(fn("world", 1)).To(Equal(0))
+			Expect(fn("hello", 1)).To(Equal(2))
+
+			_, err := fn("hello", 1)
+			Expect(err).To(MatchError("Ope"))
+		})
+	})
+
+	Describe("appendVariadic", func() {
+		It("appends the variadic arguments as interface values", func() {
+			Expect(appendVariadic([]interface{}{"SELECT 1"}, []int{1, 2})).To(Equal([]interface{}{"SELECT 1", 1, 2}))
+		})
+
+		It("returns the arguments without variadic arguments", func() {
+			Expect(appendVariadic([]interface{}{"SELECT 1"}, []int(nil))).To(Equal([]interface{}{"SELECT 1"}))
+		})
+	})
+
+	Describe("typedReturnFunc", func() {
+		It("calls the function with the arguments and returns its return values", func() {
+			returnFunc := typedReturnFunc(func(str string, err error) (int, error) {
+				return len(str), err
+			})
+
+			Expect(returnFunc([]interface{}{"hello", nil})).To(Equal([]interface{}{5, nil}))
+		})
+
+		It("calls a variadic function with the variadic arguments as a slice", func() {
+			returnFunc := typedReturnFunc(func(nums ...int) int {
+				return len(nums)
+			})
+
+			Expect(returnFunc([]interface{}{[]int{1, 2, 3}})).To(Equal([]interface{}{3}))
+		})
+
+		It("returns nil for a nil function", func() {
+			var fn func() int
+
+			Expect(typedReturnFunc(fn)).To(BeNil())
+		})
+	})
+
+	Describe("valueAt", func() {
+		It("returns the value as the provided type", func() {
+			Expect(valueAt[string]([]interface{}{"hello"}, 0)).To(Equal("hello"))
+		})
+
+		It("returns the zero value if the value is nil", func() {
+			Expect(valueAt[error]([]interface{}{nil}, 0)).To(BeNil())
+		})
+
+		It("returns the zero value if the index is out of range", func() {
+			Expect(valueAt[int]([]interface{}{42}, 1)).To(Equal(0))
+			Expect(valueAt[int]([]interface{}{42}, -1)).To(Equal(0))
+		})
+	})
+})
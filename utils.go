@@ -73,33 +73,119 @@ func validateOutParameters(functionType reflect.Type, outParameters []interface{
 	return isValid
 }
 
-// areTypeAndValueEquivalent does a kind wise check an interface{} to determine type equivalency
+// areTypeAndValueEquivalent returns true if the value can be assigned to a
+// variable of the type, either as is or after converting it the same way
+// Go converts an untyped constant
 func areTypeAndValueEquivalent(originalType reflect.Type, val interface{}) bool {
+	_, ok := convertValue(originalType, val)
+	return ok
+}
+
+// convertValue returns the value as it is assigned to a variable of the type.
+// A value with the default type of an untyped constant, like 5 or 2.5, is
+// converted to the type if Go accepts it as a constant of the type.
+func convertValue(originalType reflect.Type, val interface{}) (interface{}, bool) {
 	if originalType == nil {
-		return false
+		return nil, false
 	}
 
-	switch originalKind := originalType.Kind(); originalKind {
-	case reflect.Func, reflect.Chan, reflect.Map, reflect.Ptr, reflect.Slice:
-		if val == nil {
-			return true
-		}
+	if val == nil {
+		return nil, isNillable(originalType.Kind())
+	}
 
-		return originalKind == reflect.TypeOf(val).Kind()
-	case reflect.Interface:
-		if val == nil {
-			return true
-		}
+	v := reflect.ValueOf(val)
+	switch {
+	case v.Type().AssignableTo(originalType):
+		return val, true
+	case isUntypedConstantConvertible(v, originalType):
+		return v.Convert(originalType).Interface(), true
+	default:
+		return nil, false
+	}
+}
+
+// convertOutParameters returns the out parameters as they are returned
+// by a function of the type
+func convertOutParameters(functionType reflect.Type, outParameters []interface{}) []interface{} {
+	converted := make([]interface{}, len(outParameters))
+	for i, outParameter := range outParameters {
+		converted[i], _ = convertValue(functionType.Out(i), outParameter)
+	}
+
+	return converted
+}
+
+// untypedConstantTypes are the types Go gives untyped constants by default
+var untypedConstantTypes = map[reflect.Type]struct{}{
+	reflect.TypeOf(false): {},
+	reflect.TypeOf(0):     {},
+	reflect.TypeOf(0.0):   {},
+	reflect.TypeOf(0i):    {},
+	reflect.TypeOf(""):    {},
+}
 
-		return reflect.TypeOf(val).Implements(originalType)
+// isUntypedConstantConvertible returns true if the value has the default type
+// of an untyped constant and Go accepts it as a constant of the type
+func isUntypedConstantConvertible(v reflect.Value, t reflect.Type) bool {
+	if _, ok := untypedConstantTypes[v.Type()]; !ok || !v.Type().ConvertibleTo(t) {
+		return false
+	}
+
+	switch {
+	case isNumericKind(v.Kind()) && isNumericKind(t.Kind()):
+		return isConstantConversion(v, t)
 	default:
-		v := reflect.ValueOf(val)
-		if !v.IsValid() {
-			return false
-		}
+		return v.Kind() == t.Kind()
+	}
+}
 
-		return originalKind == v.Type().Kind()
+// isConstantConversion returns true if Go accepts the numeric value as a
+// constant of the type. Integer types must represent the value exactly and
+// keep its sign, while floating-point and complex types round the value and
+// only reject values that overflow them, so 0.1 is accepted for a float32.
+func isConstantConversion(v reflect.Value, t reflect.Type) bool {
+	switch {
+	case v.Kind() == reflect.Float64 && isFloatKind(t.Kind()):
+		return !reflect.Zero(t).OverflowFloat(v.Float())
+	case v.Kind() == reflect.Complex128 && isComplexKind(t.Kind()):
+		return !reflect.Zero(t).OverflowComplex(v.Complex())
+	case isFloatKind(t.Kind()) || isComplexKind(t.Kind()):
+		return true
+	case v.Kind() == reflect.Int && v.Int() < 0 && isUnsignedKind(t.Kind()):
+		return false
 	}
+
+	return v.Convert(t).Convert(v.Type()).Interface() == v.Interface()
+}
+
+// isNillable returns true if nil can be assigned to a variable of the kind
+func isNillable(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+		return true
+	}
+
+	return false
+}
+
+// isNumericKind returns true for integer, floating-point and complex kinds
+func isNumericKind(kind reflect.Kind) bool {
+	return kind >= reflect.Int && kind <= reflect.Complex128
+}
+
+// isUnsignedKind returns true for unsigned integer kinds
+func isUnsignedKind(kind reflect.Kind) bool {
+	return kind >= reflect.Uint && kind <= reflect.Uintptr
+}
+
+// isFloatKind returns true for floating-point kinds
+func isFloatKind(kind reflect.Kind) bool {
+	return kind == reflect.Float32 || kind == reflect.Float64
+}
+
+// isComplexKind returns true for complex kinds
+func isComplexKind(kind reflect.Kind) bool {
+	return kind == reflect.Complex64 || kind == reflect.Complex128
 }
 
 // mapToTypeName maps a slice of interface values to their type names
@@ -112,53 +198,80 @@ func mapToTypeName(interfaces []interface{}) []string {
 	return names
 }
 
-// toFriendlyName returns a type name is a more human readable string
+// toFriendlyName returns a type name is a more human readable string.
+// Named types are qualified by their package, like time.Duration.
 func toFriendlyName(value interface{}) string {
+	return toTypeName(value, reflect.Type.String)
+}
+
+// toQualifiedName returns the friendly name of the type with named types
+// qualified by their import path, like example.com/api/v1.User
+func toQualifiedName(value interface{}) string {
+	return toTypeName(value, qualifiedTypeName)
+}
+
+// qualifiedTypeName returns the name of a named type qualified by its import path
+func qualifiedTypeName(t reflect.Type) string {
+	if t.PkgPath() == "" {
+		return t.String()
+	}
+
+	return t.PkgPath() + "." + t.Name()
+}
+
+// toTypeName returns a type name is a more human readable string,
+// naming named types with the provided function
+func toTypeName(value interface{}, named func(reflect.Type) string) string {
 	if value == nil {
 		return "<nil>"
 	}
 
-	switch t := getType(value); t.Kind() {
+	t := getType(value)
+	if t.Name() != "" {
+		return named(t)
+	}
+
+	switch t.Kind() {
 	case reflect.Ptr:
-		return "*" + toFriendlyName(t.Elem())
+		return "*" + toTypeName(t.Elem(), named)
 	case reflect.Slice:
-		return fmt.Sprintf("[]%v", toFriendlyName(t.Elem()))
+		return fmt.Sprintf("[]%v", toTypeName(t.Elem(), named))
 	case reflect.Array:
-		return fmt.Sprintf("[%v]%v", t.Len(), toFriendlyName(t.Elem()))
+		return fmt.Sprintf("[%v]%v", t.Len(), toTypeName(t.Elem(), named))
 	case reflect.Map:
-		return fmt.Sprintf("map[%v]%v", toFriendlyName(t.Key()), toFriendlyName(t.Elem()))
+		return fmt.Sprintf("map[%v]%v", toTypeName(t.Key(), named), toTypeName(t.Elem(), named))
 	case reflect.Chan:
-		return toChannelFriendlyName(t)
+		return toChannelTypeName(t, named)
 	case reflect.Func:
-		return toFunctionFriendlyName(t)
+		return toFunctionTypeName(t, named)
 	default:
-		return t.Name()
+		return t.String()
 	}
 }
 
-// toChannelFriendlyName returns the friendly name for a channel
-func toChannelFriendlyName(t reflect.Type) string {
+// toChannelTypeName returns the friendly name for a channel
+func toChannelTypeName(t reflect.Type, named func(reflect.Type) string) string {
 	switch t.ChanDir() {
 	case reflect.RecvDir:
-		return fmt.Sprintf("<-chan %v", toFriendlyName(t.Elem()))
+		return fmt.Sprintf("<-chan %v", toTypeName(t.Elem(), named))
 	case reflect.SendDir:
-		return fmt.Sprintf("chan<- %v", toFriendlyName(t.Elem()))
+		return fmt.Sprintf("chan<- %v", toTypeName(t.Elem(), named))
 	default:
-		return fmt.Sprintf("chan %v", toFriendlyName(t.Elem()))
+		return fmt.Sprintf("chan %v", toTypeName(t.Elem(), named))
 	}
 }
 
-// toFunctionFriendlyName returns the friendly name for a function
-func toFunctionFriendlyName(t reflect.Type) string {
+// toFunctionTypeName returns the friendly name for a function
+func toFunctionTypeName(t reflect.Type, named func(reflect.Type) string) string {
 	args := make([]string, t.NumIn())
 	for i := 0; i < t.NumIn(); i++ {
-		args[i] = toFriendlyName(t.In(i))
+		args[i] = toTypeName(t.In(i), named)
 	}
 
 	if t.NumOut() > 0 {
 		out := make([]string, t.NumOut())
 		for i := 0; i < t.NumOut(); i++ {
-			out[i] = toFriendlyName(t.Out(i))
+			out[i] = toTypeName(t.Out(i), named)
 		}
 		return fmt.Sprintf("func(%v) (%v) {}", strings.Join(args, ", "), strings.Join(out, ", "))
 	}
@@ -166,6 +279,47 @@ func toFunctionFriendlyName(t reflect.Type) string {
 	return fmt.Sprintf("func(%v) {}", strings.Join(args, ", "))
 }
 
+// mapToDistinctTypeNames maps a slice of interface values to their type names.
+// If different types among the values share a friendly name, like two User
+// types from packages both named v1, every name is qualified by its import path.
+func mapToDistinctTypeNames(values []interface{}) []string {
+	names := mapToTypeName(values)
+	types := make(map[string]reflect.Type, len(names))
+	for i, value := range values {
+		if t, ok := types[names[i]]; ok && t != getType(value) {
+			return mapToQualifiedNames(values)
+		}
+
+		types[names[i]] = getType(value)
+	}
+
+	return names
+}
+
+// mapToQualifiedNames maps a slice of interface values to
+// their type names qualified by their import path
+func mapToQualifiedNames(values []interface{}) []string {
+	names := make([]string, len(values))
+	for i, value := range values {
+		names[i] = toQualifiedName(value)
+	}
+
+	return names
+}
+
+// distinctTypeNames returns the names of the expected types and of the types
+// of the received values, qualified by their import path if different types
+// among them share a friendly name
+func distinctTypeNames(expected []reflect.Type, received []interface{}) ([]string, []string) {
+	values := make([]interface{}, 0, len(expected)+len(received))
+	for _, t := range expected {
+		values = append(values, t)
+	}
+
+	names := mapToDistinctTypeNames(append(values, received...))
+	return names[:len(expected)], names[len(expected):]
+}
+
 // getType returns the type of the argument
 func getType(value interface{}) reflect.Type {
 	switch value.(type) {
@@ -3,7 +3,10 @@ package mocka
 import (
 	"errors"
 	"fmt"
+	htmltemplate "html/template"
 	"reflect"
+	"text/template"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -189,13 +192,125 @@ var _ = Describe("utils", func() {
 			nilables := map[reflect.Type]interface{}{
 				reflect.TypeOf((string)("")):   150,
 				reflect.TypeOf((int)(1)):       "asdf",
-				reflect.TypeOf((float64)(1.5)): 30,
+				reflect.TypeOf((float64)(1.5)): true,
 			}
 
 			for valueType, value := range nilables {
 				Expect(areTypeAndValueEquivalent(valueType, value)).To(BeFalse())
 			}
 		})
+
+		It("returns false if the types have the same kind but are not assignable", func() {
+			type otherThing struct {
+				name string
+			}
+			type status int
+
+			Expect(areTypeAndValueEquivalent(reflect.TypeOf(&Thing{}), &otherThing{})).To(BeFalse())
+			Expect(areTypeAndValueEquivalent(reflect.TypeOf(status(0)), int32(1))).To(BeFalse())
+			Expect(areTypeAndValueEquivalent(reflect.TypeOf(Thing{}), nil)).To(BeFalse())
+		})
+	})
+
+	Describe("convertValue", func() {
+		type status int
+		type name string
+
+		It("returns the value if it is assignable to the type", func() {
+			namer := Namer(&Thing{})
+
+			converted, ok := convertValue(reflect.TypeOf(&namer).Elem(), &Thing{"The Thing"})
+
+			Expect(ok).To(BeTrue())
+			Expect(converted).To(Equal(&Thing{"The Thing"}))
+		})
+
+		It("returns nil for a nillable type", func() {
+			converted, ok := convertValue(reflect.TypeOf([]int{}), nil)
+
+			Expect(ok).To(BeTrue())
+			Expect(converted).To(BeNil())
+		})
+
+		It("converts values with the default type of an untyped constant", func() {
+			conversions := map[reflect.Type][]interface{}{
+				reflect.TypeOf(int64(0)):     {5, int64(5)},
+				reflect.TypeOf(uint8(0)):     {255, uint8(255)},
+				reflect.TypeOf(float32(0)):   {2.5, float32(2.5)},
+				reflect.TypeOf(float64(-1)):  {0.1, float64(0.1)},
+				reflect.TypeOf(int8(0)):      {2.0, int8(2)},
+				reflect.TypeOf(float64(0)):   {30, float64(30)},
+				reflect.TypeOf(status(0)):    {1, status(1)},
+				reflect.TypeOf(name("")):     {"Jon", name("Jon")},
+				reflect.TypeOf(complex64(0)): {1i, complex64(1i)},
+			}
+
+			for valueType, conversion := range conversions {
+				converted, ok := convertValue(valueType, conversion[0])
+
+				Expect(ok).To(BeTrue())
+				Expect(converted).To(Equal(conversion[1]))
+			}
+		})
+
+		It("does not convert values that lose information", func() {
+			conversions := map[reflect.Type]interface{}{
+				reflect.TypeOf(int8(0)):    300,
+				reflect.TypeOf(uint(0)):    -1,
+				reflect.TypeOf(int(0)):     2.5,
+				reflect.TypeOf(string("")): 65,
+				reflect.TypeOf(uint8(0)):   2.5,
+			}
+
+			for valueType, value := range conversions {
+				_, ok := convertValue(valueType, value)
+
+				Expect(ok).To(BeFalse())
+			}
+		})
+
+		It("rounds values converted to floating-point and complex types like Go does for constants", func() {
+			conversions := map[reflect.Type][]interface{}{
+				reflect.TypeOf(float32(0)):   {0.1, float32(0.1)},
+				reflect.TypeOf(float32(1)):   {16777217, float32(16777216)},
+				reflect.TypeOf(complex64(0)): {0.1i, complex64(0.1i)},
+			}
+
+			for valueType, conversion := range conversions {
+				converted, ok := convertValue(valueType, conversion[0])
+
+				Expect(ok).To(BeTrue())
+				Expect(converted).To(Equal(conversion[1]))
+			}
+		})
+
+		It("does not convert values that overflow floating-point and complex types", func() {
+			_, ok := convertValue(reflect.TypeOf(float32(0)), 1e300)
+			Expect(ok).To(BeFalse())
+
+			_, ok = convertValue(reflect.TypeOf(complex64(0)), 1e300i)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("does not convert values that are not of the default type of an untyped constant", func() {
+			_, ok := convertValue(reflect.TypeOf(int64(0)), int32(5))
+
+			Expect(ok).To(BeFalse())
+		})
+
+		It("returns false if the type is nil", func() {
+			_, ok := convertValue(nil, 5)
+
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("convertOutParameters", func() {
+		It("converts the out parameters to the out parameter types", func() {
+			fn := func() (int64, error) { return 0, nil }
+
+			Expect(convertOutParameters(reflect.TypeOf(fn), []interface{}{5, nil})).To(Equal([]interface{}{int64(5), nil}))
+		})
 	})
 
 	Describe("mapToTypeName", func() {
@@ -209,7 +324,7 @@ var _ = Describe("utils", func() {
 
 			result := mapToTypeName(input)
 
-			Expect(result).To(Equal([]string{"*Thing", "int", "<nil>", "string", "float64", "*errorString", "thisIsAStruct", "*string"}))
+			Expect(result).To(Equal([]string{"*mocka.Thing", "int", "<nil>", "string", "float64", "*errors.errorString", "mocka.thisIsAStruct", "*string"}))
 		})
 
 		It("returns an empty slice if passed a nil", func() {
@@ -250,14 +365,45 @@ var _ = Describe("utils", func() {
 			return 0, nil
 		}, "func(int, string) (int, error) {}"),
 		Entry("for Func without out parameters", func(_ int, _ string) {}, "func(int, string) {}"),
-		Entry("for Interface", new(Stub), "*Stub"),
+		Entry("for Interface", new(interface{}), "*interface {}"),
+		Entry("for named Interface", new(error), "*error"),
 		Entry("for Map", map[int]string{}, "map[int]string"),
-		Entry("for Ptr", &Stub{}, "*Stub"),
+		Entry("for Ptr", &Stub{}, "*mocka.Stub"),
 		Entry("for Slice", []int{1, 2, 3}, "[]int"),
 		Entry("for String", "hello", "string"),
-		Entry("for Struct", Stub{}, "Stub"),
+		Entry("for Struct", Stub{}, "mocka.Stub"),
+		Entry("for named type", time.Duration(0), "time.Duration"),
 	)
 
+	Describe("toQualifiedName", func() {
+		It("qualifies named types by their import path", func() {
+			Expect(toQualifiedName(map[string][]*htmltemplate.Template{})).To(Equal("map[string][]*html/template.Template"))
+			Expect(toQualifiedName(func(time.Duration) error { return nil })).To(Equal("func(time.Duration) (error) {}"))
+			Expect(toQualifiedName(nil)).To(Equal("<nil>"))
+		})
+	})
+
+	Describe("mapToDistinctTypeNames", func() {
+		It("returns the friendly names if no different types share a name", func() {
+			Expect(mapToDistinctTypeNames([]interface{}{&template.Template{}, &template.Template{}, nil})).To(Equal([]string{"*template.Template", "*template.Template", "<nil>"}))
+		})
+
+		It("qualifies the names by their import path if different types share a name", func() {
+			values := []interface{}{&template.Template{}, &htmltemplate.Template{}, 1}
+
+			Expect(mapToDistinctTypeNames(values)).To(Equal([]string{"*text/template.Template", "*html/template.Template", "int"}))
+		})
+	})
+
+	Describe("distinctTypeNames", func() {
+		It("returns the names of the expected types and of the received values", func() {
+			expected, received := distinctTypeNames([]reflect.Type{reflect.TypeOf(&template.Template{})}, []interface{}{&htmltemplate.Template{}})
+
+			Expect(expected).To(Equal([]string{"*text/template.Template"}))
+			Expect(received).To(Equal([]string{"*html/template.Template"}))
+		})
+	})
+
 	Describe("isVariadicArgument", func() {
 		var fnType reflect.Type
 